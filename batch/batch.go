@@ -0,0 +1,223 @@
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	httpt "github.com/DefinitelyNotAGoat/twelvedata/http"
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/pkg/errors"
+)
+
+// MaxRequests - the most requests twelvedata runs in a single batch call, larger batches are split across calls
+const MaxRequests = 120
+
+// errNotSent - the result of an Entry whose Batch has not been sent yet
+var errNotSent = errors.New("batch has not been sent")
+
+type postFn func(ctx context.Context, u *url.URL, body []byte, credits int) ([]byte, error)
+
+// Client - Exposes an interface to interact with Twelvedata's batch API: https://twelvedata.com/docs#batch-requests
+type Client interface {
+	Do(b *Batch) error
+	DoWithContext(ctx context.Context, b *Batch) error
+}
+
+type client struct {
+	apiKey    string
+	baseURL   string
+	c         *http.Client
+	postFn    postFn
+	creditsFn func(urls ...*url.URL) int
+}
+
+// New - returns a new Twelvedata's batch Client
+func New(apiKey string, c *http.Client, opts ...httpt.Option) Client {
+	httpClient := httpt.NewClient(c, opts...)
+
+	return &client{
+		apiKey:    apiKey,
+		baseURL:   httpClient.BaseURL(),
+		c:         c,
+		postFn:    httpClient.Post,
+		creditsFn: httpClient.Credits,
+	}
+}
+
+// Request - a typed request that can be queued on a Batch, see the *Request builders in the core and indicators packages
+type Request[T any] struct {
+	Endpoint string
+	Params   url.Values
+}
+
+// Batch - a set of heterogeneous requests sent together
+type Batch struct {
+	entries []entry
+}
+
+type entry interface {
+	path(apiKey string) string
+	resolve(raw json.RawMessage, err error)
+}
+
+// Entry - the typed result of a Request queued on a Batch, available once the Batch has been sent
+type Entry[T any] struct {
+	request Request[T]
+	value   T
+	err     error
+}
+
+// NewBatch - returns an empty Batch
+func NewBatch() *Batch {
+	return &Batch{}
+}
+
+// Add - queues r on b, returning the Entry its result will be delivered to
+func Add[T any](b *Batch, r Request[T]) *Entry[T] {
+	e := &Entry[T]{
+		request: r,
+		err:     errNotSent,
+	}
+	b.entries = append(b.entries, e)
+
+	return e
+}
+
+// Len - the number of requests queued on b
+func (b *Batch) Len() int {
+	return len(b.entries)
+}
+
+// Result - the decoded response of the request, or the error twelvedata answered it with
+func (e *Entry[T]) Result() (T, error) {
+	return e.value, e.err
+}
+
+func (e *Entry[T]) path(apiKey string) string {
+	params := url.Values{}
+	for key, values := range e.request.Params {
+		params[key] = values
+	}
+	params.Set("apikey", apiKey)
+
+	return fmt.Sprintf("/%s?%s", e.request.Endpoint, params.Encode())
+}
+
+func (e *Entry[T]) resolve(raw json.RawMessage, err error) {
+	var value T
+	e.value = value
+	e.err = err
+	if err != nil {
+		return
+	}
+
+	if err := model.CheckAPIError(raw); err != nil {
+		e.err = err
+		return
+	}
+
+	if err := json.Unmarshal(raw, &e.value); err != nil {
+		e.err = err
+		return
+	}
+
+	if localizer, ok := any(&e.value).(model.Localizer); ok {
+		e.err = localizer.Localize(e.request.Params.Get("timezone"))
+	}
+}
+
+// Do - sends every request queued on b, delivering the results to their entries.
+// The returned error only reports a failure of the batch call itself, per-request errors are found on each Entry.
+func (c *client) Do(b *Batch) error {
+	return c.DoWithContext(context.Background(), b)
+}
+
+// DoWithContext - same as Do, but bound to ctx
+func (c *client) DoWithContext(ctx context.Context, b *Batch) error {
+	for start := 0; start < len(b.entries); start += MaxRequests {
+		end := start + MaxRequests
+		if end > len(b.entries) {
+			end = len(b.entries)
+		}
+
+		if err := c.send(ctx, b.entries[start:end]); err != nil {
+			for _, e := range b.entries[start:] {
+				e.resolve(nil, err)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (c *client) send(ctx context.Context, entries []entry) error {
+	u, err := url.Parse(fmt.Sprintf("%s/batch", c.baseURL))
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse base URL '%s'", c.baseURL)
+	}
+	u.RawQuery = url.Values{"apikey": {c.apiKey}}.Encode()
+
+	type batchRequest struct {
+		URL string `json:"url"`
+	}
+
+	requests := make(map[string]batchRequest, len(entries))
+	urls := make([]*url.URL, 0, len(entries))
+	for i, e := range entries {
+		path := e.path(c.apiKey)
+		requests[key(i)] = batchRequest{URL: path}
+
+		entryURL, err := url.Parse(path)
+		if err != nil {
+			return errors.Wrapf(err, "failed to parse batch request '%s'", path)
+		}
+		urls = append(urls, entryURL)
+	}
+
+	reqBody, err := json.Marshal(requests)
+	if err != nil {
+		return errors.Wrap(err, "failed to marshal batch request")
+	}
+
+	credits := 0
+	if c.creditsFn != nil {
+		credits = c.creditsFn(urls...)
+	}
+
+	body, err := c.postFn(ctx, u, reqBody, credits)
+	if err != nil {
+		return err
+	}
+
+	var response struct {
+		Data map[string]struct {
+			Response json.RawMessage `json:"response"`
+			Status   string          `json:"status"`
+		} `json:"data"`
+		Status string `json:"status"`
+	}
+	if err := json.Unmarshal(body, &response); err != nil {
+		return errors.Wrap(err, "failed to unmarshal batch response")
+	}
+
+	for i, e := range entries {
+		result, ok := response.Data[key(i)]
+		if !ok {
+			e.resolve(nil, errors.New("no response returned for batch request"))
+			continue
+		}
+
+		e.resolve(result.Response, nil)
+	}
+
+	return nil
+}
+
+func key(i int) string {
+	return "req_" + strconv.Itoa(i+1)
+}
@@ -0,0 +1,181 @@
+package batch
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+
+	httpt "github.com/DefinitelyNotAGoat/twelvedata/http"
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	batchBody = []byte(`{"code":200,"status":"success","data":{"req_1":{"response":{"price":"176.37000"},"status":"success"},"req_2":{"response":{"meta":{"symbol":"AAPL","interval":"1day"},"status":"ok"},"status":"success"},"req_3":{"response":{"code":400,"message":"**symbol** not found: FOOBAR.","status":"error"},"status":"error"}}}`)
+)
+
+type testPrice struct {
+	Price string `json:"price"`
+}
+
+type testSeries struct {
+	Meta   model.Meta `json:"meta"`
+	Status string     `json:"status"`
+}
+
+func TestIntegrationBatch(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	b := NewBatch()
+	Add(b, Request[testPrice]{Endpoint: "price", Params: url.Values{"symbol": {"AAPL"}}})
+
+	if err := client.Do(b); err != nil {
+		t.Log("Failed to make Batch request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitBatch(t *testing.T) {
+	type input struct {
+		postFn postFn
+	}
+
+	type want struct {
+		err      bool
+		contains string
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to post",
+			input{
+				postFn: func(ctx context.Context, u *url.URL, body []byte, credits int) ([]byte, error) {
+					return nil, errors.New("failed to post")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to post",
+			},
+		},
+		{
+			"is successful",
+			input{
+				postFn: func(ctx context.Context, u *url.URL, body []byte, credits int) ([]byte, error) {
+					return batchBody, nil
+				},
+			},
+			want{
+				err: false,
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := client{
+				apiKey: "key",
+				c:      http.DefaultClient,
+				postFn: tt.input.postFn,
+			}
+
+			b := NewBatch()
+			price := Add(b, Request[testPrice]{Endpoint: "price", Params: url.Values{"symbol": {"AAPL"}}})
+			series := Add(b, Request[testSeries]{Endpoint: "time_series", Params: url.Values{"symbol": {"AAPL"}, "interval": {"1day"}}})
+			missing := Add(b, Request[testPrice]{Endpoint: "price", Params: url.Values{"symbol": {"FOOBAR"}}})
+
+			err := client.Do(b)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				_, err = price.Result()
+				assert.NotNil(t, err)
+				return
+			}
+
+			assert.Nil(t, err)
+
+			p, err := price.Result()
+			if assert.Nil(t, err) {
+				assert.Equal(t, "176.37000", p.Price)
+			}
+
+			s, err := series.Result()
+			if assert.Nil(t, err) {
+				assert.Equal(t, "AAPL", s.Meta.Symbol)
+			}
+
+			_, err = missing.Result()
+			var apiErr *model.APIError
+			if assert.True(t, errors.As(err, &apiErr)) {
+				assert.Equal(t, 400, apiErr.Code)
+			}
+		})
+	}
+}
+
+func TestUnitBatchSplitsRequests(t *testing.T) {
+	var calls int
+	var credits int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+
+		body, _ := io.ReadAll(r.Body)
+		var requests map[string]struct {
+			URL string `json:"url"`
+		}
+		if err := json.Unmarshal(body, &requests); err != nil || r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		data := map[string]any{}
+		for key, request := range requests {
+			u, _ := url.Parse(request.URL)
+			data[key] = map[string]any{
+				"response": map[string]string{"price": u.Query().Get("symbol")},
+				"status":   "success",
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"status": "success", "data": data})
+	}))
+	defer server.Close()
+
+	limiter := httpt.NewLimiter(httpt.LimiterConfig{})
+	client := New("key", http.DefaultClient, httpt.WithBaseURL(server.URL), httpt.WithLimiter(limiter)).(*client)
+	postFn := client.postFn
+	client.postFn = func(ctx context.Context, u *url.URL, body []byte, c int) ([]byte, error) {
+		credits += c
+		return postFn(ctx, u, body, c)
+	}
+
+	b := NewBatch()
+	entries := make([]*Entry[testPrice], 0, MaxRequests+5)
+	for i := 0; i < MaxRequests+5; i++ {
+		entries = append(entries, Add(b, Request[testPrice]{Endpoint: "price", Params: url.Values{"symbol": {"AAPL"}}}))
+	}
+
+	if assert.Nil(t, client.Do(b)) {
+		assert.Equal(t, 2, calls)
+		assert.Equal(t, MaxRequests+5, credits)
+		for _, e := range entries {
+			p, err := e.Result()
+			assert.Nil(t, err)
+			assert.Equal(t, "AAPL", p.Price)
+		}
+	}
+}
@@ -1,38 +1,73 @@
 package core
 
 import (
+	"context"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 
 	httpt "github.com/DefinitelyNotAGoat/twelvedata/http"
 	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/pkg/errors"
 )
 
-const (
-	baseURI = "https://api.twelvedata.com"
-)
-
-type getFn func(u *url.URL) ([]byte, error)
+type getFn func(ctx context.Context, u *url.URL) ([]byte, error)
 
 // Client - Exposes an interface to interact with Twelvedata's core API: https://twelvedata.com/docs#core-data
 type Client interface {
 	TimeSeries(symbol string, interval model.Interval, opts TimeSeriesOptions) (TimeSeriesResponse, error)
+	TimeSeriesWithContext(ctx context.Context, symbol string, interval model.Interval, opts TimeSeriesOptions) (TimeSeriesResponse, error)
+	TimeSeriesRange(ctx context.Context, symbol string, interval model.Interval, start, end time.Time, opts TimeSeriesRangeOptions) (TimeSeriesResponse, error)
 	MarketMovers(opts MarketMoversOptions) (MarketMoversResponse, error)
+	MarketMoversWithContext(ctx context.Context, opts MarketMoversOptions) (MarketMoversResponse, error)
+	Quote(symbol string, opts QuoteOptions) (QuoteResponse, error)
+	QuoteWithContext(ctx context.Context, symbol string, opts QuoteOptions) (QuoteResponse, error)
+	Quotes(symbols []string, opts QuoteOptions) (map[string]QuoteResponse, error)
+	QuotesWithContext(ctx context.Context, symbols []string, opts QuoteOptions) (map[string]QuoteResponse, error)
+	Price(symbol string, opts PriceOptions) (PriceResponse, error)
+	PriceWithContext(ctx context.Context, symbol string, opts PriceOptions) (PriceResponse, error)
+	Prices(symbols []string, opts PriceOptions) (map[string]PriceResponse, error)
+	PricesWithContext(ctx context.Context, symbols []string, opts PriceOptions) (map[string]PriceResponse, error)
+	EOD(symbol string, opts EODOptions) (EODResponse, error)
+	EODWithContext(ctx context.Context, symbol string, opts EODOptions) (EODResponse, error)
+	EODs(symbols []string, opts EODOptions) (map[string]EODResponse, error)
+	EODsWithContext(ctx context.Context, symbols []string, opts EODOptions) (map[string]EODResponse, error)
+	ExchangeRate(symbol string, opts ExchangeRateOptions) (ExchangeRateResponse, error)
+	ExchangeRateWithContext(ctx context.Context, symbol string, opts ExchangeRateOptions) (ExchangeRateResponse, error)
+	CurrencyConversion(symbol string, amount float64, opts ExchangeRateOptions) (CurrencyConversionResponse, error)
+	CurrencyConversionWithContext(ctx context.Context, symbol string, amount float64, opts ExchangeRateOptions) (CurrencyConversionResponse, error)
 }
 
 type client struct {
-	apiKey string
-	c      *http.Client
-	getFn  getFn
+	apiKey  string
+	baseURL string
+	c       *http.Client
+	getFn   getFn
 }
 
 // New - returns a new Twelvedata's technical indicators Client
-func New(apiKey string, c *http.Client) Client {
+func New(apiKey string, c *http.Client, opts ...httpt.Option) Client {
+	httpClient := httpt.NewClient(c, opts...)
+
 	return &client{
-		apiKey: apiKey,
-		c:      c,
-		getFn: func(u *url.URL) ([]byte, error) {
-			return httpt.Get(u, c)
-		},
+		apiKey:  apiKey,
+		baseURL: httpClient.BaseURL(),
+		c:       c,
+		getFn:   httpClient.Get,
 	}
 }
+
+// parseFloat - parses one of twelvedata's string encoded numbers, an empty string is only accepted when optional
+func parseFloat(raw string, field string, optional bool) (float64, error) {
+	if raw == "" && optional {
+		return 0, nil
+	}
+
+	f, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse value %s into float", field)
+	}
+
+	return f, nil
+}
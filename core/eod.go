@@ -0,0 +1,162 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/pkg/errors"
+)
+
+// EODResponse - the end of day close for a symbol: https://twelvedata.com/docs#end-of-day-price
+type EODResponse struct {
+	Symbol    string    `json:"symbol"`
+	Exchange  string    `json:"exchange"`
+	MicCode   string    `json:"mic_code"`
+	Currency  string    `json:"currency"`
+	Datetime  time.Time `json:"datetime"`
+	Timestamp time.Time `json:"timestamp"`
+	Close     float64   `json:"close"`
+}
+
+// UnmarshalJSON - unmarshal's EODResponse to a more consumable type
+func (e *EODResponse) UnmarshalJSON(b []byte) error {
+	type RawEOD struct {
+		Symbol    string `json:"symbol"`
+		Exchange  string `json:"exchange"`
+		MicCode   string `json:"mic_code"`
+		Currency  string `json:"currency"`
+		Datetime  string `json:"datetime"`
+		Timestamp int64  `json:"timestamp"`
+		Close     string `json:"close"`
+	}
+
+	var raw RawEOD
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	close, err := parseFloat(raw.Close, "close", false)
+	if err != nil {
+		return err
+	}
+
+	dateTime, err := time.Parse(model.GetTimeFormatFromString(raw.Datetime), raw.Datetime)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse value date time into go time")
+	}
+
+	if raw.Timestamp != 0 {
+		e.Timestamp = time.Unix(raw.Timestamp, 0)
+	}
+
+	e.Symbol = raw.Symbol
+	e.Exchange = raw.Exchange
+	e.MicCode = raw.MicCode
+	e.Currency = raw.Currency
+	e.Datetime = dateTime
+	e.Close = close
+
+	return nil
+}
+
+// EODOptions - options for calling the twelvedata eod endpoint: https://twelvedata.com/docs#end-of-day-price
+type EODOptions struct {
+	Exchange string
+	MICCode  string
+	Country  string
+	Type     string
+	Date     *time.Time
+}
+
+func (e EODOptions) params(u *url.URL, urlValues url.Values) {
+	if e.Exchange != "" {
+		urlValues.Add("exchange", e.Exchange)
+	}
+
+	if e.MICCode != "" {
+		urlValues.Add("mic_code", e.MICCode)
+	}
+
+	if e.Country != "" {
+		urlValues.Add("country", e.Country)
+	}
+
+	if e.Type != "" {
+		urlValues.Add("type", e.Type)
+	}
+
+	if e.Date != nil {
+		urlValues.Add("date", e.Date.Format(model.TimeFormatMap[model.OneDay]))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// EODRequest - builds a EOD request to queue on a batch.Batch
+func EODRequest(symbol string, opts EODOptions) batch.Request[EODResponse] {
+	u := &url.URL{}
+	opts.params(u, url.Values{
+		"symbol": {symbol},
+	})
+
+	return batch.Request[EODResponse]{Endpoint: "eod", Params: u.Query()}
+}
+
+// EOD - get the end of day close for symbol
+func (c *client) EOD(symbol string, opts EODOptions) (EODResponse, error) {
+	return c.EODWithContext(context.Background(), symbol, opts)
+}
+
+// EODWithContext - same as EOD, but bound to ctx
+func (c *client) EODWithContext(ctx context.Context, symbol string, opts EODOptions) (EODResponse, error) {
+	body, err := c.eod(ctx, symbol, opts)
+	if err != nil {
+		return EODResponse{}, err
+	}
+
+	var response EODResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return EODResponse{}, err
+	}
+
+	return response, nil
+}
+
+// EODs - get the end of day closes for symbols in a single request, keyed by symbol.
+// Symbols that fail are reported through a model.SymbolErrors alongside the closes that succeeded.
+func (c *client) EODs(symbols []string, opts EODOptions) (map[string]EODResponse, error) {
+	return c.EODsWithContext(context.Background(), symbols, opts)
+}
+
+// EODsWithContext - same as EODs, but bound to ctx
+func (c *client) EODsWithContext(ctx context.Context, symbols []string, opts EODOptions) (map[string]EODResponse, error) {
+	if len(symbols) == 0 {
+		return map[string]EODResponse{}, nil
+	}
+
+	body, err := c.eod(ctx, joinSymbols(symbols), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeMulti[EODResponse](symbols, body)
+}
+
+func (c *client) eod(ctx context.Context, symbol string, opts EODOptions) ([]byte, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/eod", c.baseURL))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse base URL '%s'", c.baseURL)
+	}
+
+	opts.params(u, url.Values{
+		"symbol": {symbol},
+		"apikey": {c.apiKey},
+	})
+
+	return c.getFn(ctx, u)
+}
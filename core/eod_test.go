@@ -0,0 +1,126 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	eodBody  = []byte(`{"symbol":"AAPL","exchange":"NASDAQ","mic_code":"XNGS","currency":"USD","datetime":"2023-08-24","timestamp":1692883800,"close":"176.37000"}`)
+	eodsBody = []byte(`{"AAPL":{"symbol":"AAPL","exchange":"NASDAQ","mic_code":"XNGS","currency":"USD","datetime":"2023-08-24","timestamp":1692883800,"close":"176.37000"},"MSFT":{"symbol":"MSFT","exchange":"NASDAQ","mic_code":"XNGS","currency":"USD","datetime":"2023-08-24","timestamp":1692883800,"close":"319.97000"}}`)
+)
+
+func TestIntegrationEOD(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	_, err := client.EOD("AAPL", EODOptions{})
+	if err != nil {
+		t.Log("Failed to make EOD request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitEOD(t *testing.T) {
+	type input struct {
+		getFn getFn
+	}
+
+	type want struct {
+		err      bool
+		contains string
+		eod      EODResponse
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					if u.Query().Get("date") != "2023-08-24" {
+						return nil, errors.New("missing date")
+					}
+					return eodBody, nil
+				},
+			},
+			want{
+				err: false,
+				eod: EODResponse{
+					Symbol:    "AAPL",
+					Exchange:  "NASDAQ",
+					MicCode:   "XNGS",
+					Currency:  "USD",
+					Datetime:  time.Date(2023, 8, 24, 0, 0, 0, 0, time.UTC),
+					Timestamp: time.Unix(1692883800, 0),
+					Close:     176.37,
+				},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := client{
+				c:     http.DefaultClient,
+				getFn: tt.input.getFn,
+			}
+
+			date := time.Date(2023, 8, 24, 0, 0, 0, 0, time.UTC)
+			eod, err := client.EOD("AAPL", EODOptions{Date: &date})
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want.eod, eod)
+			}
+		})
+	}
+}
+
+func TestUnitEODs(t *testing.T) {
+	client := client{
+		c: http.DefaultClient,
+		getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+			return eodsBody, nil
+		},
+	}
+
+	eods, err := client.EODs([]string{"AAPL", "MSFT", "TSLA"}, EODOptions{})
+
+	var symbolErrors model.SymbolErrors
+	if assert.True(t, errors.As(err, &symbolErrors)) {
+		assert.Contains(t, symbolErrors["TSLA"].Error(), "no data returned")
+	}
+
+	if assert.Len(t, eods, 2) {
+		assert.Equal(t, 319.97, eods["MSFT"].Close)
+	}
+}
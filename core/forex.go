@@ -0,0 +1,166 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/pkg/errors"
+)
+
+// ExchangeRateResponse - the rate of a currency pair: https://twelvedata.com/docs#exchange-rate
+type ExchangeRateResponse struct {
+	Symbol    string    `json:"symbol"`
+	Rate      float64   `json:"rate"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// UnmarshalJSON - unmarshal's ExchangeRateResponse to a more consumable type
+func (e *ExchangeRateResponse) UnmarshalJSON(b []byte) error {
+	type RawExchangeRate struct {
+		Symbol    string          `json:"symbol"`
+		Rate      json.RawMessage `json:"rate"`
+		Timestamp int64           `json:"timestamp"`
+	}
+
+	var raw RawExchangeRate
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	rate, err := parseNumber(raw.Rate, "rate")
+	if err != nil {
+		return err
+	}
+
+	e.Symbol = raw.Symbol
+	e.Rate = rate
+	e.Timestamp = time.Unix(raw.Timestamp, 0)
+
+	return nil
+}
+
+// CurrencyConversionResponse - an amount converted between a currency pair: https://twelvedata.com/docs#currency-conversion
+type CurrencyConversionResponse struct {
+	Symbol    string    `json:"symbol"`
+	Rate      float64   `json:"rate"`
+	Amount    float64   `json:"amount"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// UnmarshalJSON - unmarshal's CurrencyConversionResponse to a more consumable type
+func (c *CurrencyConversionResponse) UnmarshalJSON(b []byte) error {
+	type RawCurrencyConversion struct {
+		Symbol    string          `json:"symbol"`
+		Rate      json.RawMessage `json:"rate"`
+		Amount    json.RawMessage `json:"amount"`
+		Timestamp int64           `json:"timestamp"`
+	}
+
+	var raw RawCurrencyConversion
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	rate, err := parseNumber(raw.Rate, "rate")
+	if err != nil {
+		return err
+	}
+
+	amount, err := parseNumber(raw.Amount, "amount")
+	if err != nil {
+		return err
+	}
+
+	c.Symbol = raw.Symbol
+	c.Rate = rate
+	c.Amount = amount
+	c.Timestamp = time.Unix(raw.Timestamp, 0)
+
+	return nil
+}
+
+// parseNumber - parses a number twelvedata sends either as a JSON number or string encoded
+func parseNumber(raw json.RawMessage, field string) (float64, error) {
+	return parseFloat(strings.Trim(string(raw), `"`), field, false)
+}
+
+// ExchangeRateOptions - options for calling the twelvedata exchange rate and currency conversion endpoints
+type ExchangeRateOptions struct {
+	// Date - requests the historical rate at this date rather than the latest
+	Date *time.Time
+}
+
+func (e ExchangeRateOptions) params(u *url.URL, urlValues url.Values) {
+	if e.Date != nil {
+		urlValues.Add("date", e.Date.Format(model.TimeFormatMap[model.OneHour]))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// ExchangeRate - get the exchange rate of a currency pair such as EUR/USD
+func (c *client) ExchangeRate(symbol string, opts ExchangeRateOptions) (ExchangeRateResponse, error) {
+	return c.ExchangeRateWithContext(context.Background(), symbol, opts)
+}
+
+// ExchangeRateWithContext - same as ExchangeRate, but bound to ctx
+func (c *client) ExchangeRateWithContext(ctx context.Context, symbol string, opts ExchangeRateOptions) (ExchangeRateResponse, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/exchange_rate", c.baseURL))
+	if err != nil {
+		return ExchangeRateResponse{}, errors.Wrapf(err, "failed to parse base URL '%s'", c.baseURL)
+	}
+
+	opts.params(u, url.Values{
+		"symbol": {symbol},
+		"apikey": {c.apiKey},
+	})
+
+	body, err := c.getFn(ctx, u)
+	if err != nil {
+		return ExchangeRateResponse{}, err
+	}
+
+	var response ExchangeRateResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return ExchangeRateResponse{}, err
+	}
+
+	return response, nil
+}
+
+// CurrencyConversion - convert amount from the base to the quote currency of a pair such as EUR/USD
+func (c *client) CurrencyConversion(symbol string, amount float64, opts ExchangeRateOptions) (CurrencyConversionResponse, error) {
+	return c.CurrencyConversionWithContext(context.Background(), symbol, amount, opts)
+}
+
+// CurrencyConversionWithContext - same as CurrencyConversion, but bound to ctx
+func (c *client) CurrencyConversionWithContext(ctx context.Context, symbol string, amount float64, opts ExchangeRateOptions) (CurrencyConversionResponse, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/currency_conversion", c.baseURL))
+	if err != nil {
+		return CurrencyConversionResponse{}, errors.Wrapf(err, "failed to parse base URL '%s'", c.baseURL)
+	}
+
+	opts.params(u, url.Values{
+		"symbol": {symbol},
+		"amount": {strconv.FormatFloat(amount, 'f', -1, 64)},
+		"apikey": {c.apiKey},
+	})
+
+	body, err := c.getFn(ctx, u)
+	if err != nil {
+		return CurrencyConversionResponse{}, err
+	}
+
+	var response CurrencyConversionResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return CurrencyConversionResponse{}, err
+	}
+
+	return response, nil
+}
@@ -0,0 +1,171 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	exchangeRateBody       = []byte(`{"symbol":"USD/JPY","rate":146.02,"timestamp":1692883800}`)
+	currencyConversionBody = []byte(`{"symbol":"USD/JPY","rate":146.02,"amount":"14602.00000","timestamp":1692883800}`)
+)
+
+func TestIntegrationExchangeRate(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	_, err := client.ExchangeRate("USD/JPY", ExchangeRateOptions{})
+	if err != nil {
+		t.Log("Failed to make ExchangeRate request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitExchangeRate(t *testing.T) {
+	type input struct {
+		getFn getFn
+	}
+
+	type want struct {
+		err      bool
+		contains string
+		rate     ExchangeRateResponse
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					if u.Query().Get("date") != "2023-08-24 00:00:00" {
+						return nil, errors.New("missing date")
+					}
+					return exchangeRateBody, nil
+				},
+			},
+			want{
+				err: false,
+				rate: ExchangeRateResponse{
+					Symbol:    "USD/JPY",
+					Rate:      146.02,
+					Timestamp: time.Unix(1692883800, 0),
+				},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := client{
+				c:     http.DefaultClient,
+				getFn: tt.input.getFn,
+			}
+
+			date := time.Date(2023, 8, 24, 0, 0, 0, 0, time.UTC)
+			rate, err := client.ExchangeRate("USD/JPY", ExchangeRateOptions{Date: &date})
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want.rate, rate)
+			}
+		})
+	}
+}
+
+func TestUnitCurrencyConversion(t *testing.T) {
+	type input struct {
+		getFn getFn
+	}
+
+	type want struct {
+		err        bool
+		contains   string
+		conversion CurrencyConversionResponse
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					if u.Query().Get("amount") != "100" {
+						return nil, errors.New("missing amount")
+					}
+					return currencyConversionBody, nil
+				},
+			},
+			want{
+				err: false,
+				conversion: CurrencyConversionResponse{
+					Symbol:    "USD/JPY",
+					Rate:      146.02,
+					Amount:    14602,
+					Timestamp: time.Unix(1692883800, 0),
+				},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := client{
+				c:     http.DefaultClient,
+				getFn: tt.input.getFn,
+			}
+
+			conversion, err := client.CurrencyConversion("USD/JPY", 100, ExchangeRateOptions{})
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want.conversion, conversion)
+			}
+		})
+	}
+}
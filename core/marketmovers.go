@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -60,16 +61,21 @@ func (m MarketMoversOptions) params(u *url.URL, urlValues url.Values) {
 
 // MarketMovers - get the biggest winners or losers depending on opts
 func (c *client) MarketMovers(opts MarketMoversOptions) (MarketMoversResponse, error) {
-	u, err := url.Parse(fmt.Sprintf("%s/time_series", baseURI))
+	return c.MarketMoversWithContext(context.Background(), opts)
+}
+
+// MarketMoversWithContext - same as MarketMovers, but bound to ctx
+func (c *client) MarketMoversWithContext(ctx context.Context, opts MarketMoversOptions) (MarketMoversResponse, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/time_series", c.baseURL))
 	if err != nil {
-		return MarketMoversResponse{}, errors.Wrapf(err, "failed to parse base URL '%s'", baseURI)
+		return MarketMoversResponse{}, errors.Wrapf(err, "failed to parse base URL '%s'", c.baseURL)
 	}
 
 	opts.params(u, url.Values{
 		"apikey": {c.apiKey},
 	})
 
-	body, err := c.getFn(u)
+	body, err := c.getFn(ctx, u)
 	if err != nil {
 		return MarketMoversResponse{}, err
 	}
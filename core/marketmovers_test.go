@@ -1,6 +1,7 @@
 package core
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/url"
@@ -45,7 +46,7 @@ func TestUnitMarketMovers(t *testing.T) {
 		{
 			"handles failure to get",
 			input{
-				getFn: func(u *url.URL) ([]byte, error) {
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
 					return nil, errors.New("failed to get")
 				},
 			},
@@ -57,7 +58,7 @@ func TestUnitMarketMovers(t *testing.T) {
 		{
 			"is successful",
 			input{
-				getFn: func(u *url.URL) ([]byte, error) {
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
 					return marketMoversBody, nil
 				},
 			},
@@ -0,0 +1,60 @@
+package core
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+)
+
+// decodeMulti - decodes a response for symbols, which twelvedata keys by symbol when more than one is requested.
+// Per-symbol failures are returned as model.SymbolErrors alongside the symbols that succeeded.
+func decodeMulti[T any](symbols []string, body []byte) (map[string]T, error) {
+	if len(symbols) == 1 {
+		var value T
+		if err := json.Unmarshal(body, &value); err != nil {
+			return nil, err
+		}
+
+		return map[string]T{symbols[0]: value}, nil
+	}
+
+	var raw map[string]json.RawMessage
+	if err := json.Unmarshal(body, &raw); err != nil {
+		return nil, err
+	}
+
+	values := make(map[string]T, len(raw))
+	symbolErrors := model.SymbolErrors{}
+	for symbol, message := range raw {
+		if err := model.CheckAPIError(message); err != nil {
+			symbolErrors[symbol] = err
+			continue
+		}
+
+		var value T
+		if err := json.Unmarshal(message, &value); err != nil {
+			symbolErrors[symbol] = err
+			continue
+		}
+
+		values[symbol] = value
+	}
+
+	for _, symbol := range symbols {
+		if _, ok := raw[symbol]; !ok {
+			symbolErrors[symbol] = errors.New("no data returned for symbol")
+		}
+	}
+
+	if len(symbolErrors) > 0 {
+		return values, symbolErrors
+	}
+
+	return values, nil
+}
+
+func joinSymbols(symbols []string) string {
+	return strings.Join(symbols, ",")
+}
@@ -0,0 +1,129 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
+	"github.com/pkg/errors"
+)
+
+// PriceResponse - the latest price for a symbol: https://twelvedata.com/docs#real-time-price
+type PriceResponse struct {
+	Price float64 `json:"price"`
+}
+
+// UnmarshalJSON - unmarshal's PriceResponse to a more consumable type
+func (p *PriceResponse) UnmarshalJSON(b []byte) error {
+	type RawPrice struct {
+		Price string `json:"price"`
+	}
+
+	var raw RawPrice
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	price, err := parseFloat(raw.Price, "price", false)
+	if err != nil {
+		return err
+	}
+
+	p.Price = price
+
+	return nil
+}
+
+// PriceOptions - options for calling the twelvedata price endpoint: https://twelvedata.com/docs#real-time-price
+type PriceOptions struct {
+	Exchange string
+	MICCode  string
+	Country  string
+	Type     string
+}
+
+func (p PriceOptions) params(u *url.URL, urlValues url.Values) {
+	if p.Exchange != "" {
+		urlValues.Add("exchange", p.Exchange)
+	}
+
+	if p.MICCode != "" {
+		urlValues.Add("mic_code", p.MICCode)
+	}
+
+	if p.Country != "" {
+		urlValues.Add("country", p.Country)
+	}
+
+	if p.Type != "" {
+		urlValues.Add("type", p.Type)
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// PriceRequest - builds a Price request to queue on a batch.Batch
+func PriceRequest(symbol string, opts PriceOptions) batch.Request[PriceResponse] {
+	u := &url.URL{}
+	opts.params(u, url.Values{
+		"symbol": {symbol},
+	})
+
+	return batch.Request[PriceResponse]{Endpoint: "price", Params: u.Query()}
+}
+
+// Price - get the latest price for symbol
+func (c *client) Price(symbol string, opts PriceOptions) (PriceResponse, error) {
+	return c.PriceWithContext(context.Background(), symbol, opts)
+}
+
+// PriceWithContext - same as Price, but bound to ctx
+func (c *client) PriceWithContext(ctx context.Context, symbol string, opts PriceOptions) (PriceResponse, error) {
+	body, err := c.price(ctx, symbol, opts)
+	if err != nil {
+		return PriceResponse{}, err
+	}
+
+	var response PriceResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return PriceResponse{}, err
+	}
+
+	return response, nil
+}
+
+// Prices - get the latest prices for symbols in a single request, keyed by symbol.
+// Symbols that fail are reported through a model.SymbolErrors alongside the prices that succeeded.
+func (c *client) Prices(symbols []string, opts PriceOptions) (map[string]PriceResponse, error) {
+	return c.PricesWithContext(context.Background(), symbols, opts)
+}
+
+// PricesWithContext - same as Prices, but bound to ctx
+func (c *client) PricesWithContext(ctx context.Context, symbols []string, opts PriceOptions) (map[string]PriceResponse, error) {
+	if len(symbols) == 0 {
+		return map[string]PriceResponse{}, nil
+	}
+
+	body, err := c.price(ctx, joinSymbols(symbols), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeMulti[PriceResponse](symbols, body)
+}
+
+func (c *client) price(ctx context.Context, symbol string, opts PriceOptions) ([]byte, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/price", c.baseURL))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse base URL '%s'", c.baseURL)
+	}
+
+	opts.params(u, url.Values{
+		"symbol": {symbol},
+		"apikey": {c.apiKey},
+	})
+
+	return c.getFn(ctx, u)
+}
@@ -0,0 +1,126 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	priceBody  = []byte(`{"price":"176.37000"}`)
+	pricesBody = []byte(`{"AAPL":{"price":"176.37000"},"MSFT":{"price":"319.97000"},"FOOBAR":{"code":400,"message":"**symbol** not found: FOOBAR.","status":"error"}}`)
+)
+
+func TestIntegrationPrice(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	_, err := client.Price("AAPL", PriceOptions{})
+	if err != nil {
+		t.Log("Failed to make Price request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitPrice(t *testing.T) {
+	type input struct {
+		getFn getFn
+	}
+
+	type want struct {
+		err      bool
+		contains string
+		price    float64
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles malformed price",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"price":"abc"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value price into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return priceBody, nil
+				},
+			},
+			want{
+				err:   false,
+				price: 176.37,
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := client{
+				c:     http.DefaultClient,
+				getFn: tt.input.getFn,
+			}
+
+			price, err := client.Price("AAPL", PriceOptions{Exchange: "NASDAQ"})
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want.price, price.Price)
+			}
+		})
+	}
+}
+
+func TestUnitPrices(t *testing.T) {
+	client := client{
+		c: http.DefaultClient,
+		getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+			return pricesBody, nil
+		},
+	}
+
+	prices, err := client.Prices([]string{"AAPL", "MSFT", "FOOBAR"}, PriceOptions{})
+
+	var symbolErrors model.SymbolErrors
+	if assert.True(t, errors.As(err, &symbolErrors)) {
+		assert.Contains(t, symbolErrors, "FOOBAR")
+	}
+
+	assert.Equal(t, map[string]PriceResponse{
+		"AAPL": {Price: 176.37},
+		"MSFT": {Price: 319.97},
+	}, prices)
+}
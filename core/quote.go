@@ -0,0 +1,281 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/pkg/errors"
+)
+
+// QuoteResponse - the latest quote for a symbol: https://twelvedata.com/docs#quote
+type QuoteResponse struct {
+	Symbol        string       `json:"symbol"`
+	Name          string       `json:"name"`
+	Exchange      string       `json:"exchange"`
+	MicCode       string       `json:"mic_code"`
+	Currency      string       `json:"currency"`
+	Datetime      time.Time    `json:"datetime"`
+	Timestamp     time.Time    `json:"timestamp"`
+	Open          float64      `json:"open"`
+	High          float64      `json:"high"`
+	Low           float64      `json:"low"`
+	Close         float64      `json:"close"`
+	Volume        float64      `json:"volume"`
+	PreviousClose float64      `json:"previous_close"`
+	Change        float64      `json:"change"`
+	PercentChange float64      `json:"percent_change"`
+	AverageVolume float64      `json:"average_volume"`
+	IsMarketOpen  bool         `json:"is_market_open"`
+	FiftyTwoWeek  FiftyTwoWeek `json:"fifty_two_week"`
+}
+
+// FiftyTwoWeek - the 52 week range of a QuoteResponse
+type FiftyTwoWeek struct {
+	Low               float64 `json:"low"`
+	High              float64 `json:"high"`
+	LowChange         float64 `json:"low_change"`
+	HighChange        float64 `json:"high_change"`
+	LowChangePercent  float64 `json:"low_change_percent"`
+	HighChangePercent float64 `json:"high_change_percent"`
+	Range             string  `json:"range"`
+}
+
+// UnmarshalJSON - unmarshal's QuoteResponse to a more consumable type
+func (q *QuoteResponse) UnmarshalJSON(b []byte) error {
+	type RawQuote struct {
+		Symbol        string          `json:"symbol"`
+		Name          string          `json:"name"`
+		Exchange      string          `json:"exchange"`
+		MicCode       string          `json:"mic_code"`
+		Currency      string          `json:"currency"`
+		Datetime      string          `json:"datetime"`
+		Timestamp     int64           `json:"timestamp"`
+		Open          string          `json:"open"`
+		High          string          `json:"high"`
+		Low           string          `json:"low"`
+		Close         string          `json:"close"`
+		Volume        string          `json:"volume"`
+		PreviousClose string          `json:"previous_close"`
+		Change        string          `json:"change"`
+		PercentChange string          `json:"percent_change"`
+		AverageVolume string          `json:"average_volume"`
+		IsMarketOpen  bool            `json:"is_market_open"`
+		FiftyTwoWeek  json.RawMessage `json:"fifty_two_week"`
+	}
+
+	var raw RawQuote
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	fields := []struct {
+		raw      string
+		name     string
+		optional bool
+		dst      *float64
+	}{
+		{raw.Open, "open", false, &q.Open},
+		{raw.High, "high", false, &q.High},
+		{raw.Low, "low", false, &q.Low},
+		{raw.Close, "close", false, &q.Close},
+		{raw.Volume, "volume", true, &q.Volume},
+		{raw.PreviousClose, "previous close", true, &q.PreviousClose},
+		{raw.Change, "change", true, &q.Change},
+		{raw.PercentChange, "percent change", true, &q.PercentChange},
+		{raw.AverageVolume, "average volume", true, &q.AverageVolume},
+	}
+	for _, field := range fields {
+		f, err := parseFloat(field.raw, field.name, field.optional)
+		if err != nil {
+			return err
+		}
+		*field.dst = f
+	}
+
+	if len(raw.FiftyTwoWeek) > 0 {
+		if err := json.Unmarshal(raw.FiftyTwoWeek, &q.FiftyTwoWeek); err != nil {
+			return errors.Wrap(err, "failed to parse value fifty two week")
+		}
+	}
+
+	if raw.Datetime != "" {
+		dateTime, err := time.Parse(model.GetTimeFormatFromString(raw.Datetime), raw.Datetime)
+		if err != nil {
+			return errors.Wrap(err, "failed to parse value date time into go time")
+		}
+		q.Datetime = dateTime
+	}
+
+	if raw.Timestamp != 0 {
+		q.Timestamp = time.Unix(raw.Timestamp, 0)
+	}
+
+	q.Symbol = raw.Symbol
+	q.Name = raw.Name
+	q.Exchange = raw.Exchange
+	q.MicCode = raw.MicCode
+	q.Currency = raw.Currency
+	q.IsMarketOpen = raw.IsMarketOpen
+
+	return nil
+}
+
+// UnmarshalJSON - unmarshal's FiftyTwoWeek to a more consumable type
+func (f *FiftyTwoWeek) UnmarshalJSON(b []byte) error {
+	type RawFiftyTwoWeek struct {
+		Low               string `json:"low"`
+		High              string `json:"high"`
+		LowChange         string `json:"low_change"`
+		HighChange        string `json:"high_change"`
+		LowChangePercent  string `json:"low_change_percent"`
+		HighChangePercent string `json:"high_change_percent"`
+		Range             string `json:"range"`
+	}
+
+	var raw RawFiftyTwoWeek
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	fields := []struct {
+		raw  string
+		name string
+		dst  *float64
+	}{
+		{raw.Low, "low", &f.Low},
+		{raw.High, "high", &f.High},
+		{raw.LowChange, "low change", &f.LowChange},
+		{raw.HighChange, "high change", &f.HighChange},
+		{raw.LowChangePercent, "low change percent", &f.LowChangePercent},
+		{raw.HighChangePercent, "high change percent", &f.HighChangePercent},
+	}
+	for _, field := range fields {
+		v, err := parseFloat(field.raw, field.name, true)
+		if err != nil {
+			return err
+		}
+		*field.dst = v
+	}
+
+	f.Range = raw.Range
+
+	return nil
+}
+
+// QuoteOptions - options for calling the twelvedata quote endpoint: https://twelvedata.com/docs#quote
+type QuoteOptions struct {
+	Interval         model.Interval
+	Exchange         string
+	MICCode          string
+	Country          string
+	Type             string
+	VolumeTimePeriod int
+	EOD              bool
+	RollingPeriod    int
+}
+
+func (q QuoteOptions) params(u *url.URL, urlValues url.Values) {
+	if q.Interval != "" {
+		urlValues.Add("interval", string(q.Interval))
+	}
+
+	if q.Exchange != "" {
+		urlValues.Add("exchange", q.Exchange)
+	}
+
+	if q.MICCode != "" {
+		urlValues.Add("mic_code", q.MICCode)
+	}
+
+	if q.Country != "" {
+		urlValues.Add("country", q.Country)
+	}
+
+	if q.Type != "" {
+		urlValues.Add("type", q.Type)
+	}
+
+	if q.VolumeTimePeriod > 0 {
+		urlValues.Add("volume_time_period", strconv.Itoa(q.VolumeTimePeriod))
+	}
+
+	if q.EOD {
+		urlValues.Add("eod", "true")
+	}
+
+	if q.RollingPeriod > 0 {
+		urlValues.Add("rolling_period", strconv.Itoa(q.RollingPeriod))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// QuoteRequest - builds a Quote request to queue on a batch.Batch
+func QuoteRequest(symbol string, opts QuoteOptions) batch.Request[QuoteResponse] {
+	u := &url.URL{}
+	opts.params(u, url.Values{
+		"symbol": {symbol},
+	})
+
+	return batch.Request[QuoteResponse]{Endpoint: "quote", Params: u.Query()}
+}
+
+// Quote - get the latest quote for symbol
+func (c *client) Quote(symbol string, opts QuoteOptions) (QuoteResponse, error) {
+	return c.QuoteWithContext(context.Background(), symbol, opts)
+}
+
+// QuoteWithContext - same as Quote, but bound to ctx
+func (c *client) QuoteWithContext(ctx context.Context, symbol string, opts QuoteOptions) (QuoteResponse, error) {
+	body, err := c.quote(ctx, symbol, opts)
+	if err != nil {
+		return QuoteResponse{}, err
+	}
+
+	var response QuoteResponse
+	if err := json.Unmarshal(body, &response); err != nil {
+		return QuoteResponse{}, err
+	}
+
+	return response, nil
+}
+
+// Quotes - get the latest quotes for symbols in a single request, keyed by symbol.
+// Symbols that fail are reported through a model.SymbolErrors alongside the quotes that succeeded.
+func (c *client) Quotes(symbols []string, opts QuoteOptions) (map[string]QuoteResponse, error) {
+	return c.QuotesWithContext(context.Background(), symbols, opts)
+}
+
+// QuotesWithContext - same as Quotes, but bound to ctx
+func (c *client) QuotesWithContext(ctx context.Context, symbols []string, opts QuoteOptions) (map[string]QuoteResponse, error) {
+	if len(symbols) == 0 {
+		return map[string]QuoteResponse{}, nil
+	}
+
+	body, err := c.quote(ctx, joinSymbols(symbols), opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeMulti[QuoteResponse](symbols, body)
+}
+
+func (c *client) quote(ctx context.Context, symbol string, opts QuoteOptions) ([]byte, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/quote", c.baseURL))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse base URL '%s'", c.baseURL)
+	}
+
+	opts.params(u, url.Values{
+		"symbol": {symbol},
+		"apikey": {c.apiKey},
+	})
+
+	return c.getFn(ctx, u)
+}
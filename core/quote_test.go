@@ -0,0 +1,134 @@
+package core
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	quoteBody  = []byte(`{"symbol":"AAPL","name":"Apple Inc","exchange":"NASDAQ","mic_code":"XNGS","currency":"USD","datetime":"2023-08-24","timestamp":1692883800,"open":"180.67000","high":"181.10001","low":"176.00999","close":"176.37000","volume":"54945800","previous_close":"181.12000","change":"-4.75000","percent_change":"-2.62257","average_volume":"58237060","is_market_open":false,"fifty_two_week":{"low":"124.17000","high":"198.23000","low_change":"52.20000","high_change":"-21.86000","low_change_percent":"42.03914","high_change_percent":"-11.02759","range":"124.169998 - 198.229996"}}`)
+	quotesBody = []byte(`{"AAPL":{"symbol":"AAPL","name":"Apple Inc","exchange":"NASDAQ","mic_code":"XNGS","currency":"USD","datetime":"2023-08-24","timestamp":1692883800,"open":"180.67000","high":"181.10001","low":"176.00999","close":"176.37000","volume":"54945800","previous_close":"181.12000","change":"-4.75000","percent_change":"-2.62257","average_volume":"58237060","is_market_open":false,"fifty_two_week":{"low":"124.17000","high":"198.23000","low_change":"52.20000","high_change":"-21.86000","low_change_percent":"42.03914","high_change_percent":"-11.02759","range":"124.169998 - 198.229996"}},"EUR/USD":{"symbol":"EUR/USD","name":"Euro / US Dollar","exchange":"Forex","datetime":"2023-08-24","timestamp":1692883800,"open":"1.08610","high":"1.08700","low":"1.08040","close":"1.08110","previous_close":"1.08620","change":"-0.00510","percent_change":"-0.46953","is_market_open":true,"fifty_two_week":{"low":"0.95350","high":"1.12760","low_change":"0.12760","high_change":"-0.04650","low_change_percent":"13.38228","high_change_percent":"-4.12380","range":"0.953500 - 1.127600"}},"FOOBAR":{"code":400,"message":"**symbol** not found: FOOBAR.","status":"error"}}`)
+)
+
+func TestIntegrationQuote(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	_, err := client.Quote("AAPL", QuoteOptions{})
+	if err != nil {
+		t.Log("Failed to make Quote request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitQuote(t *testing.T) {
+	type input struct {
+		getFn getFn
+	}
+
+	type want struct {
+		err      bool
+		contains string
+		quote    QuoteResponse
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return quoteBody, nil
+				},
+			},
+			want{
+				err: false,
+				quote: QuoteResponse{
+					Symbol:        "AAPL",
+					PreviousClose: 181.12,
+					AverageVolume: 58237060,
+					FiftyTwoWeek: FiftyTwoWeek{
+						Low:   124.17,
+						High:  198.23,
+						Range: "124.169998 - 198.229996",
+					},
+				},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := client{
+				c:     http.DefaultClient,
+				getFn: tt.input.getFn,
+			}
+
+			quote, err := client.Quote("AAPL", QuoteOptions{})
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, tt.want.quote.Symbol, quote.Symbol)
+				assert.Equal(t, tt.want.quote.PreviousClose, quote.PreviousClose)
+				assert.Equal(t, tt.want.quote.AverageVolume, quote.AverageVolume)
+				assert.Equal(t, tt.want.quote.FiftyTwoWeek.Low, quote.FiftyTwoWeek.Low)
+				assert.Equal(t, tt.want.quote.FiftyTwoWeek.High, quote.FiftyTwoWeek.High)
+				assert.Equal(t, tt.want.quote.FiftyTwoWeek.Range, quote.FiftyTwoWeek.Range)
+			}
+		})
+	}
+}
+
+func TestUnitQuotes(t *testing.T) {
+	var query url.Values
+	client := client{
+		c: http.DefaultClient,
+		getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+			query = u.Query()
+			return quotesBody, nil
+		},
+	}
+
+	quotes, err := client.Quotes([]string{"AAPL", "EUR/USD", "FOOBAR"}, QuoteOptions{})
+	assert.Equal(t, "AAPL,EUR/USD,FOOBAR", query.Get("symbol"))
+
+	var symbolErrors model.SymbolErrors
+	if assert.True(t, errors.As(err, &symbolErrors)) {
+		var apiErr *model.APIError
+		assert.Len(t, symbolErrors, 1)
+		assert.True(t, errors.As(symbolErrors["FOOBAR"], &apiErr))
+	}
+
+	if assert.Len(t, quotes, 2) {
+		assert.Equal(t, 176.37, quotes["AAPL"].Close)
+		assert.Equal(t, float64(0), quotes["EUR/USD"].Volume)
+		assert.True(t, quotes["EUR/USD"].IsMarketOpen)
+	}
+}
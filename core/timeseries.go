@@ -1,12 +1,14 @@
 package core
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
 	"strconv"
 	"time"
 
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
 	"github.com/DefinitelyNotAGoat/twelvedata/model"
 	"github.com/pkg/errors"
 )
@@ -17,6 +19,24 @@ type TimeSeriesResponse struct {
 	Status string  `json:"status"`
 }
 
+// Localize - places every value's datetime in timezone, the timezone parameter the response was requested with, or in
+// the exchange's timezone when empty. TimeSeries and batch entries call it, a response decoded by hand stays in UTC
+func (t *TimeSeriesResponse) Localize(timezone string) error {
+	loc, err := model.ResponseLocation(timezone, t.Meta.ExchangeTimezone)
+	if err != nil {
+		return err
+	}
+	t.inLocation(loc)
+
+	return nil
+}
+
+func (t *TimeSeriesResponse) inLocation(loc *time.Location) {
+	for i := range t.Values {
+		t.Values[i].DateTime = model.InLocation(t.Values[i].DateTime, loc)
+	}
+}
+
 type Meta struct {
 	Symbol           string `json:"symbol"`
 	Interval         string `json:"interval"`
@@ -30,9 +50,9 @@ type Meta struct {
 type Value struct {
 	DateTime time.Time `json:"datetime"`
 	Open     float64   `json:"open"`
-	Close    float64   `json:"low"`
-	Low      float64   `json:"high"`
-	High     float64   `json:"close"`
+	High     float64   `json:"high"`
+	Low      float64   `json:"low"`
+	Close    float64   `json:"close"`
 	Volume   float64   `json:"volume"`
 }
 
@@ -40,9 +60,9 @@ func (v *Value) UnmarshalJSON(b []byte) error {
 	type RawValue struct {
 		DateTime string `json:"datetime"`
 		Open     string `json:"open"`
-		Close    string `json:"low"`
-		Low      string `json:"high"`
-		High     string `json:"close"`
+		High     string `json:"high"`
+		Low      string `json:"low"`
+		Close    string `json:"close"`
 		Volume   string `json:"volume"`
 	}
 
@@ -104,6 +124,8 @@ type TimeSeriesOptions struct {
 	OutputSize int
 	StartDate  *time.Time
 	EndDate    *time.Time
+	// Timezone - the timezone datetimes are returned in, model.ExchangeTimezone (the default), UTC or an IANA name
+	Timezone string
 }
 
 func (t TimeSeriesOptions) params(u *url.URL, urlValues url.Values) {
@@ -127,7 +149,7 @@ func (t TimeSeriesOptions) params(u *url.URL, urlValues url.Values) {
 		urlValues.Add("type", t.Type)
 	}
 
-	if t.OutputSize == 0 {
+	if t.OutputSize > 0 {
 		urlValues.Add("outputsize", strconv.Itoa(t.OutputSize))
 	}
 
@@ -139,13 +161,34 @@ func (t TimeSeriesOptions) params(u *url.URL, urlValues url.Values) {
 		urlValues.Add("end_date", t.EndDate.Format(model.TimeFormatMap[model.OneHour]))
 	}
 
+	if t.Timezone != "" {
+		urlValues.Add("timezone", t.Timezone)
+	}
+
 	u.RawQuery = urlValues.Encode()
 }
 
+// TimeSeriesRequest - builds a TimeSeries request to queue on a batch.Batch
+func TimeSeriesRequest(symbol string, interval model.Interval, opts TimeSeriesOptions) batch.Request[TimeSeriesResponse] {
+	u := &url.URL{}
+	opts.params(u, url.Values{
+		"symbol":   {symbol},
+		"interval": {string(interval)},
+	})
+
+	return batch.Request[TimeSeriesResponse]{Endpoint: "time_series", Params: u.Query()}
+}
+
+// TimeSeries - get the meta and time series for the requested instrument: https://twelvedata.com/docs#time-series
 func (c *client) TimeSeries(symbol string, interval model.Interval, opts TimeSeriesOptions) (TimeSeriesResponse, error) {
-	u, err := url.Parse(fmt.Sprintf("%s/time_series", baseURI))
+	return c.TimeSeriesWithContext(context.Background(), symbol, interval, opts)
+}
+
+// TimeSeriesWithContext - same as TimeSeries, but bound to ctx
+func (c *client) TimeSeriesWithContext(ctx context.Context, symbol string, interval model.Interval, opts TimeSeriesOptions) (TimeSeriesResponse, error) {
+	u, err := url.Parse(fmt.Sprintf("%s/time_series", c.baseURL))
 	if err != nil {
-		return TimeSeriesResponse{}, errors.Wrapf(err, "failed to parse base URL '%s'", baseURI)
+		return TimeSeriesResponse{}, errors.Wrapf(err, "failed to parse base URL '%s'", c.baseURL)
 	}
 
 	opts.params(u, url.Values{
@@ -154,7 +197,7 @@ func (c *client) TimeSeries(symbol string, interval model.Interval, opts TimeSer
 		"apikey":   {c.apiKey},
 	})
 
-	body, err := c.getFn(u)
+	body, err := c.getFn(ctx, u)
 	if err != nil {
 		return TimeSeriesResponse{}, err
 	}
@@ -164,5 +207,9 @@ func (c *client) TimeSeries(symbol string, interval model.Interval, opts TimeSer
 		return TimeSeriesResponse{}, err
 	}
 
+	if err := response.Localize(opts.Timezone); err != nil {
+		return TimeSeriesResponse{}, err
+	}
+
 	return response, nil
 }
@@ -0,0 +1,169 @@
+package core
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/pkg/errors"
+)
+
+// MaxOutputSize - the most data points twelvedata returns from a single request
+const MaxOutputSize = 5000
+
+// TimeSeriesRangeOptions - options for TimeSeriesRange, the StartDate, EndDate and OutputSize of TimeSeriesOptions are set per page
+type TimeSeriesRangeOptions struct {
+	TimeSeriesOptions
+	// ChunkSize - the number of bars requested per page, defaults to MaxOutputSize
+	ChunkSize int
+	// Concurrency - the number of equal slices of the window paged at once, defaults to 1 which pages the whole window sequentially
+	Concurrency int
+}
+
+type segment struct {
+	start time.Time
+	end   time.Time
+}
+
+// TimeSeriesRange - get the time series between start and end, however long, by paging back from end until start is reached.
+// Every page asks for the bars before the oldest one already returned, so market closures never cost a request.
+// The bars of every page are merged, de-duplicated by datetime and returned in chronological order.
+// The wall clocks of start and end are read in the response's timezone, see TimeSeriesOptions.Timezone.
+func (c *client) TimeSeriesRange(ctx context.Context, symbol string, interval model.Interval, start, end time.Time, opts TimeSeriesRangeOptions) (TimeSeriesResponse, error) {
+	if !interval.Valid() {
+		return TimeSeriesResponse{}, fmt.Errorf("unsupported interval '%s'", interval)
+	}
+
+	if !start.Before(end) {
+		return TimeSeriesResponse{}, errors.New("start must be before end")
+	}
+
+	chunkSize := opts.ChunkSize
+	if chunkSize <= 0 || chunkSize > MaxOutputSize {
+		chunkSize = MaxOutputSize
+	}
+
+	concurrency := opts.Concurrency
+	if concurrency <= 0 {
+		concurrency = 1
+	}
+
+	// pages depend on the previous one, so concurrency comes from paging equal slices of the window side by side
+	segments := make([]segment, concurrency)
+	step := end.Sub(start) / time.Duration(concurrency)
+	for i := range segments {
+		segments[i] = segment{start: start.Add(time.Duration(i) * step), end: start.Add(time.Duration(i+1)*step - time.Second)}
+	}
+	segments[concurrency-1].end = end
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	responses := make([][]TimeSeriesResponse, len(segments))
+	var wg sync.WaitGroup
+	var once sync.Once
+	var firstErr error
+
+	for i := range segments {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			pages, err := c.timeSeriesPages(ctx, symbol, interval, segments[i], chunkSize, opts.TimeSeriesOptions)
+			if err != nil {
+				once.Do(func() {
+					firstErr = err
+					cancel()
+				})
+				return
+			}
+			responses[i] = pages
+		}(i)
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return TimeSeriesResponse{}, firstErr
+	}
+
+	if err := ctx.Err(); err != nil {
+		return TimeSeriesResponse{}, err
+	}
+
+	var merged []TimeSeriesResponse
+	for _, pages := range responses {
+		merged = append(merged, pages...)
+	}
+
+	return mergeTimeSeries(merged), nil
+}
+
+// timeSeriesPages - pages back from the end of seg, each page ending a second before the oldest bar of the previous one
+func (c *client) timeSeriesPages(ctx context.Context, symbol string, interval model.Interval, seg segment, chunkSize int, opts TimeSeriesOptions) ([]TimeSeriesResponse, error) {
+	var pages []TimeSeriesResponse
+	for pageEnd := seg.end; !pageEnd.Before(seg.start); {
+		opts.StartDate = &seg.start
+		opts.EndDate = &pageEnd
+		opts.OutputSize = chunkSize
+
+		response, err := c.TimeSeriesWithContext(ctx, symbol, interval, opts)
+		var apiErr *model.APIError
+		if errors.As(err, &apiErr) && apiErr.IsNoData() {
+			return pages, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		pages = append(pages, response)
+		if len(response.Values) < chunkSize {
+			return pages, nil
+		}
+
+		oldest := response.Values[0].DateTime
+		for _, value := range response.Values {
+			if value.DateTime.Before(oldest) {
+				oldest = value.DateTime
+			}
+		}
+
+		// bars are in the response's timezone while the window is only read by wall clock, so compare wall clocks
+		next := time.Date(oldest.Year(), oldest.Month(), oldest.Day(), oldest.Hour(), oldest.Minute(), oldest.Second(), 0, pageEnd.Location()).Add(-time.Second)
+		if !next.Before(pageEnd) {
+			return nil, errors.Errorf("page ending %s returned no bars before it", pageEnd.Format(model.TimeFormatMap[model.OneHour]))
+		}
+		pageEnd = next
+	}
+
+	return pages, nil
+}
+
+// mergeTimeSeries - merges the values of responses de-duplicated by datetime in chronological order, keeping the first meta found
+func mergeTimeSeries(responses []TimeSeriesResponse) TimeSeriesResponse {
+	merged := TimeSeriesResponse{Status: "ok"}
+	seen := map[int64]struct{}{}
+
+	for _, response := range responses {
+		if merged.Meta.Symbol == "" {
+			merged.Meta = response.Meta
+		}
+
+		for _, value := range response.Values {
+			key := value.DateTime.UnixNano()
+			if _, ok := seen[key]; ok {
+				continue
+			}
+			seen[key] = struct{}{}
+			merged.Values = append(merged.Values, value)
+		}
+	}
+
+	sort.Slice(merged.Values, func(i, j int) bool {
+		return merged.Values[i].DateTime.Before(merged.Values[j].DateTime)
+	})
+
+	return merged
+}
@@ -0,0 +1,194 @@
+package core
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+// dailyBars - serves a daily time series for every weekday between start_date and end_date inclusive, newest first,
+// truncated to outputsize bars like the API does
+func dailyBars(t *testing.T, calls *int, mu *sync.Mutex) getFn {
+	return func(ctx context.Context, u *url.URL) ([]byte, error) {
+		mu.Lock()
+		*calls++
+		mu.Unlock()
+
+		query := u.Query()
+		outputSize, err := strconv.Atoi(query.Get("outputsize"))
+		if err != nil {
+			return nil, err
+		}
+
+		start, err := time.Parse(model.TimeFormatMap[model.OneHour], query.Get("start_date"))
+		if err != nil {
+			return nil, err
+		}
+		end, err := time.Parse(model.TimeFormatMap[model.OneHour], query.Get("end_date"))
+		if err != nil {
+			return nil, err
+		}
+
+		var values []map[string]string
+		for day := end; !day.Before(start) && len(values) < outputSize; day = day.AddDate(0, 0, -1) {
+			if day.Weekday() == time.Saturday || day.Weekday() == time.Sunday {
+				continue
+			}
+			values = append(values, map[string]string{
+				"datetime": day.Format(model.TimeFormatMap[model.OneDay]),
+				"open":     "1", "high": "2", "low": "0.5", "close": "1.5", "volume": "100",
+			})
+		}
+
+		if len(values) == 0 {
+			return nil, &model.APIError{Code: 400, Message: "No data is available on the specified dates. Try setting different start/end dates.", Status: "error"}
+		}
+
+		return json.Marshal(map[string]any{
+			"meta":   map[string]string{"symbol": "AAPL", "interval": "1day", "exchange_timezone": "America/New_York"},
+			"values": values,
+			"status": "ok",
+		})
+	}
+}
+
+func TestUnitTimeSeriesRange(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	start := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	cases := []struct {
+		name        string
+		concurrency int
+		calls       int
+	}{
+		// nine full pages of 7 bars and a last page of 2
+		{"pages sequentially", 0, 10},
+		// four slices of 22 days, each two full pages and a partial one
+		{"pages slices concurrently", 4, 12},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls int
+			var mu sync.Mutex
+			client := client{
+				c:     http.DefaultClient,
+				getFn: dailyBars(t, &calls, &mu),
+			}
+
+			response, err := client.TimeSeriesRange(context.Background(), "AAPL", model.OneDay, start, end, TimeSeriesRangeOptions{
+				ChunkSize:   7,
+				Concurrency: tt.concurrency,
+			})
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+
+			assert.Equal(t, tt.calls, calls)
+			assert.Equal(t, "AAPL", response.Meta.Symbol)
+			// 65 weekdays between the 2nd of January and the 31st of March
+			if assert.Len(t, response.Values, 65) {
+				assert.Equal(t, time.Date(2023, 1, 2, 0, 0, 0, 0, newYork), response.Values[0].DateTime)
+				assert.Equal(t, time.Date(2023, 3, 31, 0, 0, 0, 0, newYork), response.Values[64].DateTime)
+			}
+			for i := 1; i < len(response.Values); i++ {
+				assert.True(t, response.Values[i-1].DateTime.Before(response.Values[i].DateTime))
+			}
+		})
+	}
+}
+
+func TestUnitTimeSeriesRangeErrors(t *testing.T) {
+	start := time.Date(2023, 1, 2, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 3, 31, 0, 0, 0, 0, time.UTC)
+
+	t.Run("handles failure to get", func(t *testing.T) {
+		client := client{
+			c: http.DefaultClient,
+			getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+				return nil, errors.New("failed to get")
+			},
+		}
+
+		_, err := client.TimeSeriesRange(context.Background(), "AAPL", model.OneDay, start, end, TimeSeriesRangeOptions{ChunkSize: 7, Concurrency: 3})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), "failed to get")
+		}
+	})
+
+	t.Run("handles pages that do not move back", func(t *testing.T) {
+		client := client{
+			c: http.DefaultClient,
+			getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+				return []byte(`{"meta":{"symbol":"AAPL","interval":"1day","exchange_timezone":"UTC"},"values":[{"datetime":"2023-04-03","open":"1","high":"2","low":"0.5","close":"1.5","volume":"100"}],"status":"ok"}`), nil
+			},
+		}
+
+		_, err := client.TimeSeriesRange(context.Background(), "AAPL", model.OneDay, start, end, TimeSeriesRangeOptions{ChunkSize: 1})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), "returned no bars before it")
+		}
+	})
+
+	t.Run("handles invalid window", func(t *testing.T) {
+		client := client{c: http.DefaultClient}
+
+		_, err := client.TimeSeriesRange(context.Background(), "AAPL", model.OneDay, end, start, TimeSeriesRangeOptions{})
+		assert.NotNil(t, err)
+
+		_, err = client.TimeSeriesRange(context.Background(), "AAPL", model.Interval("3min"), start, end, TimeSeriesRangeOptions{})
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), fmt.Sprintf("unsupported interval '%s'", "3min"))
+		}
+	})
+
+	t.Run("handles cancelled context", func(t *testing.T) {
+		var calls int
+		var mu sync.Mutex
+		client := client{
+			c:     http.DefaultClient,
+			getFn: dailyBars(t, &calls, &mu),
+		}
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		_, err := client.TimeSeriesRange(ctx, "AAPL", model.OneDay, start, end, TimeSeriesRangeOptions{ChunkSize: 7})
+		assert.ErrorIs(t, err, context.Canceled)
+	})
+}
+
+func TestUnitTimeSeriesRangeSkipsSlicesWithoutData(t *testing.T) {
+	var calls int
+	var mu sync.Mutex
+	client := client{
+		c:     http.DefaultClient,
+		getFn: dailyBars(t, &calls, &mu),
+	}
+
+	// Friday the 6th to Monday the 9th of January in four slices of 18 hours, the two weekend slices have no data
+	// and the last slice pages back over Sunday before it is done
+	start := time.Date(2023, 1, 6, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2023, 1, 9, 0, 0, 0, 0, time.UTC)
+
+	response, err := client.TimeSeriesRange(context.Background(), "AAPL", model.OneDay, start, end, TimeSeriesRangeOptions{ChunkSize: 1, Concurrency: 4})
+	if assert.Nil(t, err) {
+		assert.Equal(t, 5, calls)
+		assert.Len(t, response.Values, 2)
+	}
+}
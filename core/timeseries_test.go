@@ -1,12 +1,18 @@
 package core
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
+	httpt "github.com/DefinitelyNotAGoat/twelvedata/http"
 	"github.com/DefinitelyNotAGoat/twelvedata/model"
 	"github.com/stretchr/testify/assert"
 )
@@ -46,7 +52,7 @@ func TestUnitTimeSeries(t *testing.T) {
 		{
 			"handles failure to get",
 			input{
-				getFn: func(u *url.URL) ([]byte, error) {
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
 					return nil, errors.New("failed to get")
 				},
 			},
@@ -58,7 +64,7 @@ func TestUnitTimeSeries(t *testing.T) {
 		{
 			"is successful",
 			input{
-				getFn: func(u *url.URL) ([]byte, error) {
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
 					return timeSeriesBody, nil
 				},
 			},
@@ -86,3 +92,153 @@ func TestUnitTimeSeries(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitTimeSeriesBaseURL(t *testing.T) {
+	var path string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		path = r.URL.Path
+		_, _ = w.Write(timeSeriesBody)
+	}))
+	defer server.Close()
+
+	client := New("key", http.DefaultClient, httpt.WithBaseURL(server.URL+"/gateway"))
+
+	response, err := client.TimeSeries("AAPL", model.OneDay, TimeSeriesOptions{})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "/gateway/time_series", path)
+		assert.Equal(t, "AAPL", response.Meta.Symbol)
+	}
+}
+
+func TestUnitTimeSeriesRequest(t *testing.T) {
+	request := TimeSeriesRequest("AAPL", model.OneDay, TimeSeriesOptions{Exchange: "NASDAQ"})
+
+	assert.Equal(t, "time_series", request.Endpoint)
+	assert.Equal(t, "AAPL", request.Params.Get("symbol"))
+	assert.Equal(t, "1day", request.Params.Get("interval"))
+	assert.Equal(t, "NASDAQ", request.Params.Get("exchange"))
+	assert.Empty(t, request.Params.Get("apikey"))
+
+	// outputsize is left to the API's default of 30 unless it is set
+	_, ok := request.Params["outputsize"]
+	assert.False(t, ok)
+
+	request = TimeSeriesRequest("AAPL", model.OneDay, TimeSeriesOptions{OutputSize: 5000})
+	assert.Equal(t, "5000", request.Params.Get("outputsize"))
+}
+
+func TestUnitTimeSeriesTimezone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	cases := []struct {
+		name     string
+		timezone string
+		want     time.Time
+	}{
+		{"uses the exchange timezone by default", "", time.Date(2023, 8, 24, 11, 9, 0, 0, newYork)},
+		{"uses the exchange timezone when asked", model.ExchangeTimezone, time.Date(2023, 8, 24, 11, 9, 0, 0, newYork)},
+		{"uses the requested timezone", "UTC", time.Date(2023, 8, 24, 11, 9, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					if u.Query().Get("timezone") != tt.timezone {
+						return nil, errors.New("unexpected timezone")
+					}
+					return timeSeriesBody, nil
+				},
+			}
+
+			response, err := client.TimeSeries("AAPL", model.OneMin, TimeSeriesOptions{Timezone: tt.timezone})
+			if assert.Nil(t, err) {
+				assert.True(t, tt.want.Equal(response.Values[0].DateTime))
+				assert.Equal(t, tt.want.Location(), response.Values[0].DateTime.Location())
+			}
+		})
+	}
+}
+
+func TestUnitTimeSeriesUnknownExchangeTimezone(t *testing.T) {
+	client := client{
+		c: http.DefaultClient,
+		getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+			return []byte(`{"meta":{"symbol":"AAPL","exchange_timezone":"Nowhere/Unknown"},"values":[{"datetime":"2023-08-24 11:09:00","open":"177.91010","high":"178.02000","low":"177.91000","close":"178.00121","volume":"293189"}],"status":"ok"}`), nil
+		},
+	}
+
+	_, err := client.TimeSeries("AAPL", model.OneMin, TimeSeriesOptions{})
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "failed to load timezone 'Nowhere/Unknown'")
+	}
+
+	response, err := client.TimeSeries("AAPL", model.OneMin, TimeSeriesOptions{Timezone: "UTC"})
+	if assert.Nil(t, err) {
+		assert.Equal(t, time.Date(2023, 8, 24, 11, 9, 0, 0, time.UTC), response.Values[0].DateTime)
+	}
+}
+
+func TestUnitTimeSeriesBatchTimezone(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var requests map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&requests); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		data := map[string]any{}
+		for key := range requests {
+			data[key] = map[string]any{"response": json.RawMessage(timeSeriesBody), "status": "success"}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"status": "success", "data": data})
+	}))
+	defer server.Close()
+
+	b := batch.NewBatch()
+	exchange := batch.Add(b, TimeSeriesRequest("AAPL", model.OneMin, TimeSeriesOptions{}))
+	tokyo := batch.Add(b, TimeSeriesRequest("AAPL", model.OneMin, TimeSeriesOptions{Timezone: "Asia/Tokyo"}))
+
+	if err := batch.New("key", http.DefaultClient, httpt.WithBaseURL(server.URL)).Do(b); !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	response, err := exchange.Result()
+	if assert.Nil(t, err) {
+		_, offset := response.Values[0].DateTime.Zone()
+		assert.Equal(t, "America/New_York", response.Values[0].DateTime.Location().String())
+		assert.Equal(t, -4*60*60, offset)
+		assert.Equal(t, 11, response.Values[0].DateTime.Hour())
+	}
+
+	response, err = tokyo.Result()
+	if assert.Nil(t, err) {
+		_, offset := response.Values[0].DateTime.Zone()
+		assert.Equal(t, "Asia/Tokyo", response.Values[0].DateTime.Location().String())
+		assert.Equal(t, 9*60*60, offset)
+		assert.Equal(t, 11, response.Values[0].DateTime.Hour())
+	}
+}
+
+func TestUnitValueUnmarshalJSON(t *testing.T) {
+	var value Value
+	err := json.Unmarshal([]byte(`{"datetime":"2023-08-24","open":"177.91","high":"178.02","low":"177.65","close":"178.00","volume":""}`), &value)
+	if assert.Nil(t, err) {
+		assert.Equal(t, 177.91, value.Open)
+		assert.Equal(t, 178.02, value.High)
+		assert.Equal(t, 177.65, value.Low)
+		assert.Equal(t, 178.00, value.Close)
+		assert.Zero(t, value.Volume)
+	}
+}
+
+func TestUnitValueMarshalJSON(t *testing.T) {
+	b, err := json.Marshal(Value{Open: 177.91, High: 178.02, Low: 177.65, Close: 178.00})
+	if assert.Nil(t, err) {
+		assert.JSONEq(t, `{"datetime":"0001-01-01T00:00:00Z","open":177.91,"high":178.02,"low":177.65,"close":178,"volume":0}`, string(b))
+	}
+}
@@ -0,0 +1,92 @@
+package twelvedata
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Result - the outcome of a FanOut call for a single symbol
+type Result[T any] struct {
+	Value T
+	Err   error
+}
+
+// FanOutOptions - options for FanOut
+type FanOutOptions struct {
+	// Workers - the number of calls in flight at once, defaults to 4
+	Workers int
+	// RequestsPerMinute - spaces calls evenly to stay within this many per minute, 0 is unlimited
+	RequestsPerMinute int
+}
+
+// FanOut - calls fn for every symbol on a bounded pool of workers, e.g. wrapping core.Client.TimeSeriesWithContext or
+// indicators.Client.RSIWithContext. A failing symbol never aborts the others, its error is recorded in its Result,
+// and symbols not yet called when ctx is done get the context's error.
+func FanOut[T any](ctx context.Context, symbols []string, fn func(ctx context.Context, symbol string) (T, error), opts FanOutOptions) map[string]Result[T] {
+	workers := opts.Workers
+	if workers <= 0 {
+		workers = 4
+	}
+
+	var pace <-chan time.Time
+	if opts.RequestsPerMinute > 0 {
+		// a rate above a billion a second would round the interval down to 0, which NewTicker panics on
+		interval := time.Minute / time.Duration(opts.RequestsPerMinute)
+		if interval <= 0 {
+			interval = time.Nanosecond
+		}
+
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+		pace = ticker.C
+	}
+
+	var mu sync.Mutex
+	results := make(map[string]Result[T], len(symbols))
+	record := func(symbol string, result Result[T]) {
+		mu.Lock()
+		results[symbol] = result
+		mu.Unlock()
+	}
+
+	work := make(chan string)
+	var wg sync.WaitGroup
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for symbol := range work {
+				if pace != nil {
+					select {
+					case <-pace:
+					case <-ctx.Done():
+						record(symbol, Result[T]{Err: ctx.Err()})
+						continue
+					}
+				}
+
+				value, err := fn(ctx, symbol)
+				record(symbol, Result[T]{Value: value, Err: err})
+			}
+		}()
+	}
+
+	seen := make(map[string]struct{}, len(symbols))
+	for _, symbol := range symbols {
+		if _, ok := seen[symbol]; ok {
+			continue
+		}
+		seen[symbol] = struct{}{}
+
+		select {
+		case work <- symbol:
+		case <-ctx.Done():
+			record(symbol, Result[T]{Err: ctx.Err()})
+		}
+	}
+	close(work)
+	wg.Wait()
+
+	return results
+}
@@ -0,0 +1,84 @@
+package twelvedata
+
+import (
+	"context"
+	"errors"
+	"math"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitFanOut(t *testing.T) {
+	var inFlight, maxInFlight int32
+	fn := func(ctx context.Context, symbol string) (string, error) {
+		current := atomic.AddInt32(&inFlight, 1)
+		defer atomic.AddInt32(&inFlight, -1)
+		for {
+			seen := atomic.LoadInt32(&maxInFlight)
+			if current <= seen || atomic.CompareAndSwapInt32(&maxInFlight, seen, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		if symbol == "FOOBAR" {
+			return "", errors.New("symbol not found")
+		}
+		return symbol + " ok", nil
+	}
+
+	symbols := []string{"AAPL", "MSFT", "FOOBAR", "TSLA", "AMZN", "NVDA", "AAPL"}
+	results := FanOut(context.Background(), symbols, fn, FanOutOptions{Workers: 2})
+
+	assert.Len(t, results, 6)
+	assert.LessOrEqual(t, atomic.LoadInt32(&maxInFlight), int32(2))
+	assert.Equal(t, "MSFT ok", results["MSFT"].Value)
+	assert.Nil(t, results["NVDA"].Err)
+	if assert.NotNil(t, results["FOOBAR"].Err) {
+		assert.Contains(t, results["FOOBAR"].Err.Error(), "symbol not found")
+	}
+}
+
+func TestUnitFanOutRequestsPerMinute(t *testing.T) {
+	start := time.Now()
+	results := FanOut(context.Background(), []string{"AAPL", "MSFT", "TSLA"}, func(ctx context.Context, symbol string) (int, error) {
+		return 1, nil
+	}, FanOutOptions{Workers: 3, RequestsPerMinute: 3000})
+
+	// 3000 per minute spaces calls 20ms apart
+	assert.GreaterOrEqual(t, time.Since(start), 60*time.Millisecond)
+	assert.Len(t, results, 3)
+}
+
+func TestUnitFanOutUnboundedRequestsPerMinute(t *testing.T) {
+	results := FanOut(context.Background(), []string{"AAPL", "MSFT"}, func(ctx context.Context, symbol string) (int, error) {
+		return 1, nil
+	}, FanOutOptions{RequestsPerMinute: math.MaxInt})
+
+	assert.Len(t, results, 2)
+}
+
+func TestUnitFanOutContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	var calls int32
+
+	results := FanOut(ctx, []string{"AAPL", "MSFT", "TSLA", "AMZN"}, func(ctx context.Context, symbol string) (int, error) {
+		atomic.AddInt32(&calls, 1)
+		cancel()
+		return 1, nil
+	}, FanOutOptions{Workers: 1, RequestsPerMinute: 6000})
+
+	assert.Len(t, results, 4)
+	assert.Equal(t, int32(1), atomic.LoadInt32(&calls))
+
+	var cancelled int
+	for _, result := range results {
+		if errors.Is(result.Err, context.Canceled) {
+			cancelled++
+		}
+	}
+	assert.Equal(t, 3, cancelled)
+}
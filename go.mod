@@ -3,6 +3,7 @@ module github.com/DefinitelyNotAGoat/twelvedata
 go 1.20
 
 require (
+	github.com/gorilla/websocket v1.5.3
 	github.com/pkg/errors v0.9.1
 	github.com/stretchr/testify v1.8.4
 )
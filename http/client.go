@@ -0,0 +1,170 @@
+package http
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// DefaultBaseURL - the twelvedata API every client targets unless configured otherwise
+const DefaultBaseURL = "https://api.twelvedata.com"
+
+// Options - the request policies shared by every twelvedata API group
+type Options struct {
+	BaseURL   string
+	UserAgent string
+	Headers   http.Header
+	Timeout   time.Duration
+	Retry     RetryPolicy
+	Limiter   *Limiter
+}
+
+// Option - configures Options when constructing a client
+type Option func(*Options)
+
+// WithBaseURL - sends requests to baseURL instead of DefaultBaseURL, e.g. a local stub or an egress gateway
+func WithBaseURL(baseURL string) Option {
+	return func(o *Options) {
+		o.BaseURL = strings.TrimRight(baseURL, "/")
+	}
+}
+
+// WithUserAgent - sets the User-Agent header sent with every request
+func WithUserAgent(userAgent string) Option {
+	return func(o *Options) {
+		o.UserAgent = userAgent
+	}
+}
+
+// WithHeader - adds a header sent with every request, can be given multiple times
+func WithHeader(key, value string) Option {
+	return func(o *Options) {
+		o.Headers.Add(key, value)
+	}
+}
+
+// WithTimeout - bounds every request attempt to timeout, independently of the caller's context
+func WithTimeout(timeout time.Duration) Option {
+	return func(o *Options) {
+		o.Timeout = timeout
+	}
+}
+
+// WithRetryPolicy - retries failed requests according to policy
+func WithRetryPolicy(policy RetryPolicy) Option {
+	return func(o *Options) {
+		o.Retry = policy
+	}
+}
+
+// WithLimiter - reserves API credits from limiter before every request attempt, share one Limiter between clients to share a budget
+func WithLimiter(limiter *Limiter) Option {
+	return func(o *Options) {
+		o.Limiter = limiter
+	}
+}
+
+// NewOptions - applies opts on top of the defaults, which make a single attempt per request against DefaultBaseURL
+func NewOptions(opts ...Option) Options {
+	options := Options{
+		BaseURL: DefaultBaseURL,
+		Headers: http.Header{},
+		Retry:   RetryPolicy{MaxAttempts: 1},
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
+
+// Client - performs requests against the twelvedata API applying the configured Options
+type Client struct {
+	c      *http.Client
+	opts   Options
+	header http.Header
+}
+
+// NewClient - returns a new Client wrapping c
+func NewClient(c *http.Client, opts ...Option) *Client {
+	options := NewOptions(opts...)
+
+	header := options.Headers.Clone()
+	if options.UserAgent != "" {
+		header.Set("User-Agent", options.UserAgent)
+	}
+
+	return &Client{
+		c:      c,
+		opts:   options,
+		header: header,
+	}
+}
+
+// BaseURL - the base URL request paths should be resolved against
+func (c *Client) BaseURL() string {
+	return c.opts.BaseURL
+}
+
+// Get - performs a GET request against u once the Limiter allows it, retrying according to the configured RetryPolicy
+func (c *Client) Get(ctx context.Context, u *url.URL) ([]byte, error) {
+	return c.do(ctx, http.MethodGet, u, nil, c.Credits(u))
+}
+
+// Post - performs a POST request with a JSON body against u once the Limiter grants credits, retrying according to the configured RetryPolicy
+func (c *Client) Post(ctx context.Context, u *url.URL, body []byte, credits int) ([]byte, error) {
+	return c.do(ctx, http.MethodPost, u, body, credits)
+}
+
+// Credits - the API credits requests to urls cost according to the Limiter, 0 when no Limiter is configured
+func (c *Client) Credits(urls ...*url.URL) int {
+	if c.opts.Limiter == nil {
+		return 0
+	}
+
+	var credits int
+	for _, u := range urls {
+		credits += c.opts.Limiter.RequestCost(u)
+	}
+
+	return credits
+}
+
+func (c *Client) do(ctx context.Context, method string, u *url.URL, body []byte, credits int) ([]byte, error) {
+	for attempt := 1; ; attempt++ {
+		// every attempt reaches the API and is billed, so each one reserves its own credits
+		if c.opts.Limiter != nil {
+			if err := c.opts.Limiter.Wait(ctx, u.Query().Get("apikey"), credits); err != nil {
+				return nil, err
+			}
+		}
+
+		respBody, retryAfter, err := c.attempt(ctx, method, u, body)
+		if err == nil {
+			return respBody, nil
+		}
+
+		if ctx.Err() != nil || attempt >= c.opts.Retry.MaxAttempts || !c.opts.Retry.retryable(err) {
+			return nil, err
+		}
+
+		if sleepErr := sleep(ctx, c.opts.Retry.backoff(attempt, retryAfter)); sleepErr != nil {
+			return nil, errors.Wrapf(err, "gave up retrying after %d attempts: %s", attempt, sleepErr.Error())
+		}
+	}
+}
+
+func (c *Client) attempt(ctx context.Context, method string, u *url.URL, body []byte) ([]byte, time.Duration, error) {
+	if c.opts.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, c.opts.Timeout)
+		defer cancel()
+	}
+
+	return do(ctx, method, u, body, c.c, c.header)
+}
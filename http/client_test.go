@@ -0,0 +1,281 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitClientGet(t *testing.T) {
+	type input struct {
+		responses []mockResponse
+		policy    RetryPolicy
+		limiter   *Limiter
+		timeout   time.Duration
+	}
+
+	type want struct {
+		err      bool
+		contains string
+		attempts int32
+	}
+
+	policy := DefaultRetryPolicy()
+	policy.BaseBackoff = time.Millisecond
+	policy.MaxBackoff = 5 * time.Millisecond
+
+	limiter := NewLimiter(LimiterConfig{CreditsPerMinute: 2, FailFast: true})
+	limiter.now = func() time.Time { return time.Date(2023, 8, 24, 11, 0, 0, 0, time.UTC) }
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"retries transient status codes",
+			input{
+				responses: []mockResponse{
+					{status: http.StatusServiceUnavailable, body: "unavailable"},
+					{status: http.StatusInternalServerError, body: "failed"},
+					{status: http.StatusOK, body: `{"status":"ok"}`},
+				},
+				policy: policy,
+			},
+			want{
+				err:      false,
+				attempts: 3,
+			},
+		},
+		{
+			"retries rate limit error payloads",
+			input{
+				responses: []mockResponse{
+					{status: http.StatusOK, body: `{"code":429,"message":"out of credits","status":"error"}`},
+					{status: http.StatusOK, body: `{"status":"ok"}`},
+				},
+				policy: policy,
+			},
+			want{
+				err:      false,
+				attempts: 2,
+			},
+		},
+		{
+			"does not retry non retryable codes",
+			input{
+				responses: []mockResponse{
+					{status: http.StatusOK, body: `{"code":400,"message":"**symbol** not found","status":"error"}`},
+					{status: http.StatusOK, body: `{"status":"ok"}`},
+				},
+				policy: policy,
+			},
+			want{
+				err:      true,
+				contains: "not found",
+				attempts: 1,
+			},
+		},
+		{
+			"gives up after max attempts",
+			input{
+				responses: []mockResponse{
+					{status: http.StatusServiceUnavailable, body: "unavailable"},
+				},
+				policy: policy,
+			},
+			want{
+				err:      true,
+				contains: "unavailable",
+				attempts: 3,
+			},
+		},
+		{
+			"reserves credits for every attempt",
+			input{
+				responses: []mockResponse{
+					{status: http.StatusServiceUnavailable, body: "unavailable"},
+				},
+				policy:  policy,
+				limiter: limiter,
+			},
+			want{
+				err:      true,
+				contains: "credits",
+				attempts: 2,
+			},
+		},
+		{
+			"makes a single attempt by default",
+			input{
+				responses: []mockResponse{
+					{status: http.StatusServiceUnavailable, body: "unavailable"},
+					{status: http.StatusOK, body: `{"status":"ok"}`},
+				},
+				policy: NewOptions().Retry,
+			},
+			want{
+				err:      true,
+				contains: "unavailable",
+				attempts: 1,
+			},
+		},
+		{
+			"stops when the context is done while honouring Retry-After",
+			input{
+				responses: []mockResponse{
+					{status: http.StatusTooManyRequests, body: "slow down", retryAfter: "60"},
+					{status: http.StatusOK, body: `{"status":"ok"}`},
+				},
+				policy:  policy,
+				timeout: 50 * time.Millisecond,
+			},
+			want{
+				err:      true,
+				contains: "context deadline exceeded",
+				attempts: 1,
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			server, attempts := newSequenceServer(tt.input.responses)
+			defer server.Close()
+
+			u, err := url.Parse(server.URL + "/time_series")
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+
+			ctx := context.Background()
+			if tt.input.timeout > 0 {
+				var cancel context.CancelFunc
+				ctx, cancel = context.WithTimeout(ctx, tt.input.timeout)
+				defer cancel()
+			}
+
+			opts := []Option{WithRetryPolicy(tt.input.policy)}
+			if tt.input.limiter != nil {
+				opts = append(opts, WithLimiter(tt.input.limiter))
+			}
+
+			client := NewClient(http.DefaultClient, opts...)
+			_, err = client.Get(ctx, u)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+			} else {
+				assert.Nil(t, err)
+			}
+			assert.Equal(t, tt.want.attempts, atomic.LoadInt32(attempts))
+		})
+	}
+}
+
+func TestUnitRetryPolicyBackoff(t *testing.T) {
+	policy := RetryPolicy{
+		BaseBackoff: 100 * time.Millisecond,
+		MaxBackoff:  time.Second,
+	}
+
+	assert.Equal(t, 100*time.Millisecond, policy.backoff(1, 0))
+	assert.Equal(t, 400*time.Millisecond, policy.backoff(3, 0))
+	assert.Equal(t, time.Second, policy.backoff(10, 0))
+	assert.Equal(t, 5*time.Second, policy.backoff(1, 5*time.Second))
+
+	policy.Jitter = 0.5
+	for i := 0; i < 100; i++ {
+		wait := policy.backoff(2, 0)
+		assert.GreaterOrEqual(t, wait, 100*time.Millisecond)
+		assert.LessOrEqual(t, wait, 300*time.Millisecond)
+	}
+}
+
+func TestUnitParseRetryAfter(t *testing.T) {
+	now := time.Date(2023, 8, 24, 11, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, time.Duration(0), parseRetryAfter("", now))
+	assert.Equal(t, 2*time.Second, parseRetryAfter("2", now))
+	assert.Equal(t, 30*time.Second, parseRetryAfter(now.Add(30*time.Second).Format(http.TimeFormat), now))
+	assert.Equal(t, time.Duration(0), parseRetryAfter("soon", now))
+}
+
+func TestUnitIsTransient(t *testing.T) {
+	assert.True(t, IsTransient(&net.OpError{Op: "dial", Err: errors.New("connection refused")}))
+	assert.False(t, IsTransient(context.Canceled))
+	assert.False(t, IsTransient(&model.APIError{Code: http.StatusBadRequest}))
+}
+
+type mockResponse struct {
+	status     int
+	body       string
+	retryAfter string
+}
+
+// newSequenceServer - serves responses in order, repeating the last one once exhausted
+func newSequenceServer(responses []mockResponse) (*httptest.Server, *int32) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		i := int(atomic.AddInt32(&attempts, 1)) - 1
+		if i >= len(responses) {
+			i = len(responses) - 1
+		}
+
+		if responses[i].retryAfter != "" {
+			w.Header().Set("Retry-After", responses[i].retryAfter)
+		}
+		w.WriteHeader(responses[i].status)
+		_, _ = w.Write([]byte(responses[i].body))
+	}))
+
+	return server, &attempts
+}
+
+func TestUnitClientOptions(t *testing.T) {
+	var header http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		header = r.Header
+		if r.URL.Path == "/slow" {
+			time.Sleep(100 * time.Millisecond)
+		}
+		_, _ = w.Write([]byte(`{"status":"ok"}`))
+	}))
+	defer server.Close()
+
+	client := NewClient(
+		http.DefaultClient,
+		WithBaseURL(server.URL+"/"),
+		WithUserAgent("desk-bot/1.0"),
+		WithHeader("X-Egress-Token", "secret"),
+		WithTimeout(20*time.Millisecond),
+	)
+	assert.Equal(t, server.URL, client.BaseURL())
+
+	u, err := url.Parse(client.BaseURL() + "/time_series")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	_, err = client.Get(context.Background(), u)
+	if assert.Nil(t, err) {
+		assert.Equal(t, "desk-bot/1.0", header.Get("User-Agent"))
+		assert.Equal(t, "secret", header.Get("X-Egress-Token"))
+	}
+
+	u.Path = "/slow"
+	_, err = client.Get(context.Background(), u)
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "deadline exceeded")
+	}
+}
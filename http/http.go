@@ -1,28 +1,111 @@
 package http
 
 import (
-	"fmt"
+	"bytes"
+	"context"
+	"encoding/json"
 	"io"
 	"net/http"
 	"net/url"
+	"strconv"
+	"strings"
+	"time"
 
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
 	"github.com/pkg/errors"
 )
 
-func Get(u *url.URL, client *http.Client) ([]byte, error) {
-	resp, err := client.Get(u.String())
+// Get - performs a GET request against u, bound to ctx so cancellation and deadlines reach the transport
+func Get(ctx context.Context, u *url.URL, client *http.Client) ([]byte, error) {
+	body, _, err := do(ctx, http.MethodGet, u, nil, client, nil)
+	return body, err
+}
+
+// Post - performs a POST request with a JSON body against u, bound to ctx
+func Post(ctx context.Context, u *url.URL, body []byte, client *http.Client) ([]byte, error) {
+	respBody, _, err := do(ctx, http.MethodPost, u, body, client, nil)
+	return respBody, err
+}
+
+// do - performs a single request attempt, also returning how long the server asked us to wait before retrying
+func do(ctx context.Context, method string, u *url.URL, reqBody []byte, client *http.Client, header http.Header) ([]byte, time.Duration, error) {
+	var reader io.Reader
+	if reqBody != nil {
+		reader = bytes.NewReader(reqBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, u.String(), reader)
 	if err != nil {
-		return nil, err
+		return nil, 0, errors.Wrap(err, "failed to build request")
+	}
+
+	for key, values := range header {
+		req.Header[key] = values
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		return nil, fmt.Errorf("unexpected status code '%d'", resp.StatusCode)
+	if reqBody != nil {
+		req.Header.Set("Content-Type", "application/json")
 	}
 
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer resp.Body.Close()
+
+	retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now())
+
 	body, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return nil, errors.Wrap(err, "failed to read response body")
+		return nil, retryAfter, errors.Wrap(err, "failed to read response body")
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, retryAfter, statusError(resp.StatusCode, body)
+	}
+
+	if err := model.CheckAPIError(body); err != nil {
+		return nil, retryAfter, err
+	}
+
+	return body, retryAfter, nil
+}
+
+func statusError(statusCode int, body []byte) *model.APIError {
+	var payload model.APIError
+	message := strings.TrimSpace(string(body))
+	if err := json.Unmarshal(body, &payload); err == nil && payload.Message != "" {
+		message = payload.Message
+	}
+
+	if message == "" {
+		message = http.StatusText(statusCode)
+	}
+
+	return &model.APIError{
+		Code:    statusCode,
+		Message: message,
+		Status:  "error",
+	}
+}
+
+// parseRetryAfter - parses a Retry-After header given either in seconds or as an HTTP date
+func parseRetryAfter(header string, now time.Time) time.Duration {
+	if header == "" {
+		return 0
+	}
+
+	if seconds, err := strconv.Atoi(header); err == nil {
+		if seconds < 0 {
+			return 0
+		}
+		return time.Duration(seconds) * time.Second
+	}
+
+	date, err := http.ParseTime(header)
+	if err != nil || !date.After(now) {
+		return 0
 	}
 
-	return body, nil
+	return date.Sub(now)
 }
@@ -2,23 +2,27 @@ package http
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"testing"
 	"time"
 
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
 	"github.com/stretchr/testify/assert"
 )
 
 func TestGet(t *testing.T) {
 	type input struct {
+		ctx            context.Context
 		mockHTTPServer mockHTTPServer
 	}
 
 	type want struct {
 		err      bool
 		contains string
+		code     int
 	}
 
 	cases := []struct {
@@ -29,17 +33,55 @@ func TestGet(t *testing.T) {
 		{
 			"handles unexpected status code",
 			input{
-				mockHTTPServer: newMockHTTPServer(t, true),
+				ctx:            context.Background(),
+				mockHTTPServer: newMockHTTPServer(t, http.StatusInternalServerError, "Failed!"),
 			},
 			want{
 				err:      true,
-				contains: "unexpected status code",
+				contains: "Failed!",
+				code:     http.StatusInternalServerError,
+			},
+		},
+		{
+			"handles error payload with unexpected status code",
+			input{
+				ctx:            context.Background(),
+				mockHTTPServer: newMockHTTPServer(t, http.StatusUnauthorized, `{"code":401,"message":"**apikey** parameter is incorrect","status":"error"}`),
+			},
+			want{
+				err:      true,
+				contains: "**apikey** parameter is incorrect",
+				code:     http.StatusUnauthorized,
+			},
+		},
+		{
+			"handles error payload with ok status code",
+			input{
+				ctx:            context.Background(),
+				mockHTTPServer: newMockHTTPServer(t, http.StatusOK, `{"code":429,"message":"You have run out of API credits for the current minute.","status":"error"}`),
+			},
+			want{
+				err:      true,
+				contains: "run out of API credits",
+				code:     http.StatusTooManyRequests,
+			},
+		},
+		{
+			"handles cancelled context",
+			input{
+				ctx:            cancelledContext(),
+				mockHTTPServer: newMockHTTPServer(t, http.StatusOK, "Success"),
+			},
+			want{
+				err:      true,
+				contains: "context canceled",
 			},
 		},
 		{
 			"is successful",
 			input{
-				mockHTTPServer: newMockHTTPServer(t, false),
+				ctx:            context.Background(),
+				mockHTTPServer: newMockHTTPServer(t, http.StatusOK, "Success"),
 			},
 			want{
 				err: false,
@@ -58,11 +100,16 @@ func TestGet(t *testing.T) {
 				t.FailNow()
 			}
 
-			_, err = Get(u, http.DefaultClient)
+			_, err = Get(tt.input.ctx, u, http.DefaultClient)
 			if tt.want.err {
 				if assert.NotNil(t, err) {
 					assert.Contains(t, err.Error(), tt.want.contains)
 				}
+
+				var apiErr *model.APIError
+				if tt.want.code != 0 && assert.True(t, errors.As(err, &apiErr)) {
+					assert.Equal(t, tt.want.code, apiErr.Code)
+				}
 			} else {
 				assert.Nil(t, err)
 			}
@@ -70,15 +117,22 @@ func TestGet(t *testing.T) {
 	}
 }
 
+func cancelledContext() context.Context {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	return ctx
+}
+
 type mockHTTPServer struct {
 	t      *testing.T
 	server *http.Server
 }
 
-func newMockHTTPServer(t *testing.T, wantErr bool) mockHTTPServer {
+func newMockHTTPServer(t *testing.T, status int, body string) mockHTTPServer {
 	return mockHTTPServer{
 		server: &http.Server{Addr: "127.0.0.1:33333", Handler: &handler{
-			wantErr: wantErr,
+			status: status,
+			body:   body,
 		}},
 		t: t,
 	}
@@ -99,19 +153,13 @@ func (m mockHTTPServer) stop() {
 }
 
 type handler struct {
-	wantErr bool
+	status int
+	body   string
 }
 
 func (h *handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	if h.wantErr {
-		w.WriteHeader(http.StatusInternalServerError)
-		if _, err := w.Write([]byte("Failed!")); err != nil {
-			fmt.Println("Failed to respond in server test.")
-		}
-		return
-	}
-
-	if _, err := w.Write([]byte("Success")); err != nil {
+	w.WriteHeader(h.status)
+	if _, err := w.Write([]byte(h.body)); err != nil {
 		fmt.Println("Failed to respond in server test.")
 	}
 }
@@ -0,0 +1,171 @@
+package http
+
+import (
+	"context"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ErrCreditsExhausted - returned by a fail fast Limiter when a request would exceed the per-minute or per-day budget
+var ErrCreditsExhausted = errors.New("api credits exhausted")
+
+// DefaultCreditCosts - the API credits each endpoint costs per symbol, endpoints not listed cost 1: https://twelvedata.com/docs#api-credits
+var DefaultCreditCosts = map[string]int{
+	"time_series":                1,
+	"quote":                      1,
+	"price":                      1,
+	"eod":                        1,
+	"exchange_rate":              1,
+	"currency_conversion":        1,
+	"market_movers/stocks":       100,
+	"market_movers/etf":          100,
+	"market_movers/mutual_funds": 100,
+	"market_movers/forex":        100,
+	"market_movers/crypto":       100,
+	"profile":                    10,
+	"dividends":                  20,
+	"splits":                     20,
+	"earnings":                   20,
+	"statistics":                 50,
+	"insider_transactions":       200,
+	"income_statement":           100,
+	"balance_sheet":              100,
+	"cash_flow":                  100,
+	"institutional_holders":      1500,
+	"fund_holders":               1500,
+}
+
+// LimiterConfig - the credit budgets enforced by a Limiter, a zero budget is unlimited
+type LimiterConfig struct {
+	CreditsPerMinute int
+	CreditsPerDay    int
+	// FailFast - return ErrCreditsExhausted instead of blocking until the budget resets
+	FailFast bool
+	// Costs - per endpoint credit costs overriding DefaultCreditCosts
+	Costs map[string]int
+}
+
+// Limiter - a client side API credit limiter, every client given the same Limiter and API key shares one budget
+type Limiter struct {
+	config  LimiterConfig
+	now     func() time.Time
+	mu      sync.Mutex
+	budgets map[string]*budget
+}
+
+type budget struct {
+	minute     time.Time
+	minuteUsed int
+	day        time.Time
+	dayUsed    int
+}
+
+// NewLimiter - returns a new Limiter enforcing config
+func NewLimiter(config LimiterConfig) *Limiter {
+	return &Limiter{
+		config:  config,
+		now:     time.Now,
+		budgets: map[string]*budget{},
+	}
+}
+
+// Cost - the credits a request to endpoint costs for a single symbol
+func (l *Limiter) Cost(endpoint string) int {
+	endpoint = strings.Trim(endpoint, "/")
+	if cost, ok := l.config.Costs[endpoint]; ok {
+		return cost
+	}
+
+	if cost, ok := DefaultCreditCosts[endpoint]; ok {
+		return cost
+	}
+
+	return 1
+}
+
+// RequestCost - the credits a request to u costs, matching the endpoint on the trailing path segments and charging per symbol
+func (l *Limiter) RequestCost(u *url.URL) int {
+	segments := strings.Split(strings.Trim(u.Path, "/"), "/")
+	cost := 1
+	for i := range segments {
+		endpoint := strings.Join(segments[i:], "/")
+		_, custom := l.config.Costs[endpoint]
+		_, known := DefaultCreditCosts[endpoint]
+		if custom || known {
+			cost = l.Cost(endpoint)
+			break
+		}
+	}
+
+	if symbols := u.Query().Get("symbol"); symbols != "" {
+		cost *= strings.Count(symbols, ",") + 1
+	}
+
+	return cost
+}
+
+// Wait - reserves credits from apiKey's budget, blocking until they are available unless the Limiter fails fast
+func (l *Limiter) Wait(ctx context.Context, apiKey string, credits int) error {
+	if (l.config.CreditsPerMinute > 0 && credits > l.config.CreditsPerMinute) ||
+		(l.config.CreditsPerDay > 0 && credits > l.config.CreditsPerDay) {
+		return errors.Wrapf(ErrCreditsExhausted, "request costs %d credits which exceeds the configured budget", credits)
+	}
+
+	for {
+		wait, err := l.reserve(apiKey, credits)
+		if err != nil || wait == 0 {
+			return err
+		}
+
+		if err := sleep(ctx, wait); err != nil {
+			return errors.Wrap(err, "gave up waiting for api credits")
+		}
+	}
+}
+
+// reserve - takes credits if both budgets allow it, otherwise returns how long until the exhausted budget resets
+func (l *Limiter) reserve(apiKey string, credits int) (time.Duration, error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now().UTC()
+	minute := now.Truncate(time.Minute)
+	day := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+
+	b, ok := l.budgets[apiKey]
+	if !ok {
+		b = &budget{}
+		l.budgets[apiKey] = b
+	}
+
+	if !b.minute.Equal(minute) {
+		b.minute, b.minuteUsed = minute, 0
+	}
+
+	if !b.day.Equal(day) {
+		b.day, b.dayUsed = day, 0
+	}
+
+	if l.config.CreditsPerDay > 0 && b.dayUsed+credits > l.config.CreditsPerDay {
+		if l.config.FailFast {
+			return 0, errors.Wrapf(ErrCreditsExhausted, "daily budget of %d credits used", l.config.CreditsPerDay)
+		}
+		return day.AddDate(0, 0, 1).Sub(now), nil
+	}
+
+	if l.config.CreditsPerMinute > 0 && b.minuteUsed+credits > l.config.CreditsPerMinute {
+		if l.config.FailFast {
+			return 0, errors.Wrapf(ErrCreditsExhausted, "per minute budget of %d credits used", l.config.CreditsPerMinute)
+		}
+		return minute.Add(time.Minute).Sub(now), nil
+	}
+
+	b.minuteUsed += credits
+	b.dayUsed += credits
+
+	return 0, nil
+}
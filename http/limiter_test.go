@@ -0,0 +1,96 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitLimiterRequestCost(t *testing.T) {
+	limiter := NewLimiter(LimiterConfig{
+		Costs: map[string]int{"ema": 2},
+	})
+
+	cases := []struct {
+		name  string
+		input string
+		want  int
+	}{
+		{"costs one credit by default", "https://api.twelvedata.com/time_series?symbol=AAPL", 1},
+		{"charges per symbol", "https://api.twelvedata.com/quote?symbol=AAPL,MSFT,TSLA", 3},
+		{"matches nested endpoints", "https://api.twelvedata.com/market_movers/stocks", 100},
+		{"matches behind a gateway prefix", "https://gateway.local/twelvedata/statistics?symbol=AAPL", 50},
+		{"applies overrides", "https://api.twelvedata.com/ema?symbol=AAPL", 2},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			u, err := url.Parse(tt.input)
+			if !assert.Nil(t, err) {
+				t.FailNow()
+			}
+
+			assert.Equal(t, tt.want, limiter.RequestCost(u))
+		})
+	}
+}
+
+func TestUnitLimiterWait(t *testing.T) {
+	t.Run("fails fast once the minute budget is used", func(t *testing.T) {
+		limiter := NewLimiter(LimiterConfig{CreditsPerMinute: 8, FailFast: true})
+
+		assert.Nil(t, limiter.Wait(context.Background(), "key", 5))
+		assert.Nil(t, limiter.Wait(context.Background(), "key", 3))
+		assert.True(t, errors.Is(limiter.Wait(context.Background(), "key", 1), ErrCreditsExhausted))
+	})
+
+	t.Run("fails fast once the day budget is used", func(t *testing.T) {
+		limiter := NewLimiter(LimiterConfig{CreditsPerMinute: 8, CreditsPerDay: 10, FailFast: true})
+		now := time.Date(2023, 8, 24, 11, 0, 0, 0, time.UTC)
+		limiter.now = func() time.Time { return now }
+
+		assert.Nil(t, limiter.Wait(context.Background(), "key", 8))
+		now = now.Add(time.Minute)
+		assert.Nil(t, limiter.Wait(context.Background(), "key", 2))
+		now = now.Add(time.Minute)
+		assert.True(t, errors.Is(limiter.Wait(context.Background(), "key", 1), ErrCreditsExhausted))
+	})
+
+	t.Run("keeps separate budgets per api key", func(t *testing.T) {
+		limiter := NewLimiter(LimiterConfig{CreditsPerMinute: 1, FailFast: true})
+
+		assert.Nil(t, limiter.Wait(context.Background(), "first", 1))
+		assert.Nil(t, limiter.Wait(context.Background(), "second", 1))
+		assert.NotNil(t, limiter.Wait(context.Background(), "first", 1))
+	})
+
+	t.Run("rejects requests larger than the budget", func(t *testing.T) {
+		limiter := NewLimiter(LimiterConfig{CreditsPerMinute: 8})
+
+		assert.True(t, errors.Is(limiter.Wait(context.Background(), "key", 100), ErrCreditsExhausted))
+	})
+
+	t.Run("blocks until the next minute", func(t *testing.T) {
+		limiter := NewLimiter(LimiterConfig{CreditsPerMinute: 1})
+		start, clock := time.Date(2023, 8, 24, 11, 0, 59, 980000000, time.UTC), time.Now()
+		limiter.now = func() time.Time { return start.Add(time.Since(clock)) }
+
+		assert.Nil(t, limiter.Wait(context.Background(), "key", 1))
+		assert.Nil(t, limiter.Wait(context.Background(), "key", 1))
+		assert.GreaterOrEqual(t, time.Since(clock), 20*time.Millisecond)
+	})
+
+	t.Run("stops blocking when the context is done", func(t *testing.T) {
+		limiter := NewLimiter(LimiterConfig{CreditsPerMinute: 1})
+		limiter.now = func() time.Time { return time.Date(2023, 8, 24, 11, 0, 0, 0, time.UTC) }
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+		defer cancel()
+
+		assert.Nil(t, limiter.Wait(ctx, "key", 1))
+		assert.True(t, errors.Is(limiter.Wait(ctx, "key", 1), context.DeadlineExceeded))
+	})
+}
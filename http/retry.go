@@ -0,0 +1,111 @@
+package http
+
+import (
+	"context"
+	"errors"
+	"io"
+	"math"
+	"math/rand"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+)
+
+// RetryPolicy - controls how failed requests are retried
+type RetryPolicy struct {
+	// MaxAttempts - the total number of attempts, including the first; values below 2 disable retries
+	MaxAttempts int
+	// BaseBackoff - the wait before the first retry, doubled on every subsequent retry
+	BaseBackoff time.Duration
+	// MaxBackoff - caps the exponential backoff, a Retry-After header may still ask for longer
+	MaxBackoff time.Duration
+	// Jitter - the fraction (0-1) by which each backoff is randomly shortened or lengthened
+	Jitter float64
+	// RetryableStatusCodes - API error codes worth retrying, matched against both HTTP statuses and error payloads
+	RetryableStatusCodes []int
+	// RetryableError - decides whether an error that is not an API error is worth retrying
+	RetryableError func(err error) bool
+}
+
+// DefaultRetryPolicy - a sensible policy retrying rate limits, server errors and transient network failures
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts: 3,
+		BaseBackoff: 500 * time.Millisecond,
+		MaxBackoff:  30 * time.Second,
+		Jitter:      0.2,
+		RetryableStatusCodes: []int{
+			http.StatusTooManyRequests,
+			http.StatusInternalServerError,
+			http.StatusBadGateway,
+			http.StatusServiceUnavailable,
+			http.StatusGatewayTimeout,
+		},
+		RetryableError: IsTransient,
+	}
+}
+
+// IsTransient - reports whether err is a network failure or timeout that may succeed on another attempt
+func IsTransient(err error) bool {
+	if errors.Is(err, context.Canceled) {
+		return false
+	}
+
+	if errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return true
+	}
+
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+func (r RetryPolicy) retryable(err error) bool {
+	var apiErr *model.APIError
+	if errors.As(err, &apiErr) {
+		for _, code := range r.RetryableStatusCodes {
+			if apiErr.Code == code {
+				return true
+			}
+		}
+		return false
+	}
+
+	if r.RetryableError == nil {
+		return false
+	}
+
+	return r.RetryableError(err)
+}
+
+// backoff - the wait before the given retry (1 for the first retry), never shorter than retryAfter
+func (r RetryPolicy) backoff(retry int, retryAfter time.Duration) time.Duration {
+	wait := float64(r.BaseBackoff) * math.Pow(2, float64(retry-1))
+	if r.MaxBackoff > 0 && wait > float64(r.MaxBackoff) {
+		wait = float64(r.MaxBackoff)
+	}
+
+	if r.Jitter > 0 {
+		wait += wait * r.Jitter * (2*rand.Float64() - 1)
+	}
+
+	if d := time.Duration(wait); d > retryAfter {
+		return d
+	}
+
+	return retryAfter
+}
+
+// sleep - waits for d, returning early with the context's error if ctx is done first
+func sleep(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
@@ -0,0 +1,287 @@
+package indicators
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/pkg/errors"
+)
+
+// CandlestickPattern - a candlestick pattern recognition endpoint
+type CandlestickPattern string
+
+// candlestickWorkers - the number of pattern requests Candlesticks keeps in flight at once
+const candlestickWorkers = 4
+
+const (
+	// CDL2Crows - Two Crows
+	CDL2Crows CandlestickPattern = "cdl2crows"
+	// CDL3BlackCrows - Three Black Crows
+	CDL3BlackCrows CandlestickPattern = "cdl3blackcrows"
+	// CDL3Inside - Three Inside Up/Down
+	CDL3Inside CandlestickPattern = "cdl3inside"
+	// CDL3LineStrike - Three-Line Strike
+	CDL3LineStrike CandlestickPattern = "cdl3linestrike"
+	// CDL3Outside - Three Outside Up/Down
+	CDL3Outside CandlestickPattern = "cdl3outside"
+	// CDL3StarsInSouth - Three Stars In The South
+	CDL3StarsInSouth CandlestickPattern = "cdl3starsinsouth"
+	// CDL3WhiteSoldiers - Three Advancing White Soldiers
+	CDL3WhiteSoldiers CandlestickPattern = "cdl3whitesoldiers"
+	// CDLAbandonedBaby - Abandoned Baby
+	CDLAbandonedBaby CandlestickPattern = "cdlabandonedbaby"
+	// CDLAdvanceBlock - Advance Block
+	CDLAdvanceBlock CandlestickPattern = "cdladvanceblock"
+	// CDLBeltHold - Belt-hold
+	CDLBeltHold CandlestickPattern = "cdlbelthold"
+	// CDLBreakaway - Breakaway
+	CDLBreakaway CandlestickPattern = "cdlbreakaway"
+	// CDLClosingMarubozu - Closing Marubozu
+	CDLClosingMarubozu CandlestickPattern = "cdlclosingmarubozu"
+	// CDLConcealBabysWall - Concealing Baby Swallow
+	CDLConcealBabysWall CandlestickPattern = "cdlconcealbabyswall"
+	// CDLCounterAttack - Counterattack
+	CDLCounterAttack CandlestickPattern = "cdlcounterattack"
+	// CDLDarkCloudCover - Dark Cloud Cover
+	CDLDarkCloudCover CandlestickPattern = "cdldarkcloudcover"
+	// CDLDoji - Doji
+	CDLDoji CandlestickPattern = "cdldoji"
+	// CDLDojiStar - Doji Star
+	CDLDojiStar CandlestickPattern = "cdldojistar"
+	// CDLDragonflyDoji - Dragonfly Doji
+	CDLDragonflyDoji CandlestickPattern = "cdldragonflydoji"
+	// CDLEngulfing - Engulfing Pattern
+	CDLEngulfing CandlestickPattern = "cdlengulfing"
+	// CDLEveningDojiStar - Evening Doji Star
+	CDLEveningDojiStar CandlestickPattern = "cdleveningdojistar"
+	// CDLEveningStar - Evening Star
+	CDLEveningStar CandlestickPattern = "cdleveningstar"
+	// CDLGapSideSideWhite - Up/Down-gap side-by-side white lines
+	CDLGapSideSideWhite CandlestickPattern = "cdlgapsidesidewhite"
+	// CDLGravestoneDoji - Gravestone Doji
+	CDLGravestoneDoji CandlestickPattern = "cdlgravestonedoji"
+	// CDLHammer - Hammer
+	CDLHammer CandlestickPattern = "cdlhammer"
+	// CDLHangingMan - Hanging Man
+	CDLHangingMan CandlestickPattern = "cdlhangingman"
+	// CDLHarami - Harami Pattern
+	CDLHarami CandlestickPattern = "cdlharami"
+	// CDLHaramiCross - Harami Cross Pattern
+	CDLHaramiCross CandlestickPattern = "cdlharamicross"
+	// CDLHighWave - High-Wave Candle
+	CDLHighWave CandlestickPattern = "cdlhighwave"
+	// CDLHikkake - Hikkake Pattern
+	CDLHikkake CandlestickPattern = "cdlhikkake"
+	// CDLHikkakeMod - Modified Hikkake Pattern
+	CDLHikkakeMod CandlestickPattern = "cdlhikkakemod"
+	// CDLHomingPigeon - Homing Pigeon
+	CDLHomingPigeon CandlestickPattern = "cdlhomingpigeon"
+	// CDLIdentical3Crows - Identical Three Crows
+	CDLIdentical3Crows CandlestickPattern = "cdlidentical3crows"
+	// CDLInNeck - In-Neck Pattern
+	CDLInNeck CandlestickPattern = "cdlinneck"
+	// CDLInvertedHammer - Inverted Hammer
+	CDLInvertedHammer CandlestickPattern = "cdlinvertedhammer"
+	// CDLKicking - Kicking
+	CDLKicking CandlestickPattern = "cdlkicking"
+	// CDLKickingByLength - Kicking, bull or bear determined by the longer marubozu
+	CDLKickingByLength CandlestickPattern = "cdlkickingbylength"
+	// CDLLadderBottom - Ladder Bottom
+	CDLLadderBottom CandlestickPattern = "cdlladderbottom"
+	// CDLLongLeggedDoji - Long Legged Doji
+	CDLLongLeggedDoji CandlestickPattern = "cdllongleggeddoji"
+	// CDLLongLine - Long Line Candle
+	CDLLongLine CandlestickPattern = "cdllongline"
+	// CDLMarubozu - Marubozu
+	CDLMarubozu CandlestickPattern = "cdlmarubozu"
+	// CDLMatchingLow - Matching Low
+	CDLMatchingLow CandlestickPattern = "cdlmatchinglow"
+	// CDLMatHold - Mat Hold
+	CDLMatHold CandlestickPattern = "cdlmathold"
+	// CDLMorningDojiStar - Morning Doji Star
+	CDLMorningDojiStar CandlestickPattern = "cdlmorningdojistar"
+	// CDLMorningStar - Morning Star
+	CDLMorningStar CandlestickPattern = "cdlmorningstar"
+	// CDLOnNeck - On-Neck Pattern
+	CDLOnNeck CandlestickPattern = "cdlonneck"
+	// CDLPiercing - Piercing Pattern
+	CDLPiercing CandlestickPattern = "cdlpiercing"
+	// CDLRickshawMan - Rickshaw Man
+	CDLRickshawMan CandlestickPattern = "cdlrickshawman"
+	// CDLRiseFall3Methods - Rising/Falling Three Methods
+	CDLRiseFall3Methods CandlestickPattern = "cdlrisefall3methods"
+	// CDLSeparatingLines - Separating Lines
+	CDLSeparatingLines CandlestickPattern = "cdlseparatinglines"
+	// CDLShootingStar - Shooting Star
+	CDLShootingStar CandlestickPattern = "cdlshootingstar"
+	// CDLShortLine - Short Line Candle
+	CDLShortLine CandlestickPattern = "cdlshortline"
+	// CDLSpinningTop - Spinning Top
+	CDLSpinningTop CandlestickPattern = "cdlspinningtop"
+	// CDLStalledPattern - Stalled Pattern
+	CDLStalledPattern CandlestickPattern = "cdlstalledpattern"
+	// CDLStickSandwich - Stick Sandwich
+	CDLStickSandwich CandlestickPattern = "cdlsticksandwich"
+	// CDLTakuri - Takuri, a Dragonfly Doji with a very long lower shadow
+	CDLTakuri CandlestickPattern = "cdltakuri"
+	// CDLTasukiGap - Tasuki Gap
+	CDLTasukiGap CandlestickPattern = "cdltasukigap"
+	// CDLThrusting - Thrusting Pattern
+	CDLThrusting CandlestickPattern = "cdlthrusting"
+	// CDLTristar - Tristar Pattern
+	CDLTristar CandlestickPattern = "cdltristar"
+	// CDLUnique3River - Unique 3 River
+	CDLUnique3River CandlestickPattern = "cdlunique3river"
+	// CDLUpsideGap2Crows - Upside Gap Two Crows
+	CDLUpsideGap2Crows CandlestickPattern = "cdlupsidegap2crows"
+	// CDLXSideGap3Methods - Upside/Downside Gap Three Methods
+	CDLXSideGap3Methods CandlestickPattern = "cdlxsidegap3methods"
+)
+
+// CandlestickPatterns - every candlestick pattern endpoint twelvedata offers, the 61 patterns TA-Lib recognises
+var CandlestickPatterns = []CandlestickPattern{
+	CDL2Crows, CDL3BlackCrows, CDL3Inside, CDL3LineStrike, CDL3Outside, CDL3StarsInSouth, CDL3WhiteSoldiers,
+	CDLAbandonedBaby, CDLAdvanceBlock, CDLBeltHold, CDLBreakaway, CDLClosingMarubozu, CDLConcealBabysWall,
+	CDLCounterAttack, CDLDarkCloudCover, CDLDoji, CDLDojiStar, CDLDragonflyDoji, CDLEngulfing, CDLEveningDojiStar,
+	CDLEveningStar, CDLGapSideSideWhite, CDLGravestoneDoji, CDLHammer, CDLHangingMan, CDLHarami, CDLHaramiCross,
+	CDLHighWave, CDLHikkake, CDLHikkakeMod, CDLHomingPigeon, CDLIdentical3Crows, CDLInNeck, CDLInvertedHammer,
+	CDLKicking, CDLKickingByLength, CDLLadderBottom, CDLLongLeggedDoji, CDLLongLine, CDLMarubozu, CDLMatchingLow,
+	CDLMatHold, CDLMorningDojiStar, CDLMorningStar, CDLOnNeck, CDLPiercing, CDLRickshawMan, CDLRiseFall3Methods,
+	CDLSeparatingLines, CDLShootingStar, CDLShortLine, CDLSpinningTop, CDLStalledPattern, CDLStickSandwich, CDLTakuri,
+	CDLTasukiGap, CDLThrusting, CDLTristar, CDLUnique3River, CDLUpsideGap2Crows, CDLXSideGap3Methods,
+}
+
+// CandlestickIndicator - the Indicator value for IndicatorMeta specific for candlestick patterns
+type CandlestickIndicator struct {
+	Name string `json:"name"`
+}
+
+// CandlestickValue - the Indicator value for IndicatorResponse specific for candlestick patterns, Signal is positive
+// (usually 100) for a bullish pattern, negative for a bearish one and 0 where the pattern doesn't occur
+type CandlestickValue struct {
+	Datetime time.Time `json:"datetime"`
+	Signal   int       `json:"signal"`
+}
+
+// UnmarshalJSON - unmarshal's CandlestickValue to a more consumable type, the signal being keyed by the pattern's
+// endpoint name, e.g. cdlengulfing
+func (c *CandlestickValue) UnmarshalJSON(v []byte) error {
+	var value map[string]string
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value["datetime"])
+	if err != nil {
+		return err
+	}
+
+	// every pattern endpoint is named cdl*, so its key can't be confused with the datetime or include_ohlc's bars
+	var patterns []string
+	for key := range value {
+		if strings.HasPrefix(key, "cdl") {
+			patterns = append(patterns, key)
+		}
+	}
+
+	if len(patterns) != 1 {
+		sort.Strings(patterns)
+		return errors.Errorf("expected the signal of exactly one candlestick pattern, got %d: %v", len(patterns), patterns)
+	}
+
+	signal, err := parseFloat(value[patterns[0]], patterns[0])
+	if err != nil {
+		return err
+	}
+
+	c.Datetime = dateTime
+	c.Signal = int(signal)
+
+	return nil
+}
+
+func (c *CandlestickValue) inLocation(loc *time.Location) {
+	c.Datetime = model.InLocation(c.Datetime, loc)
+}
+
+// CandlestickOptions - options for calling the twelvedata candlestick pattern endpoints, e.g.
+// https://twelvedata.com/docs#cdlengulfing
+type CandlestickOptions struct {
+	IndicatorOptions
+}
+
+func (c CandlestickOptions) params(u *url.URL, urlValues url.Values) {
+	u.RawQuery = c.IndicatorOptions.params(u, urlValues).Encode()
+}
+
+// CandlestickRequest - builds a candlestick pattern request to queue on a batch.Batch
+func CandlestickRequest(symbol string, interval model.Interval, pattern CandlestickPattern, opts CandlestickOptions) batch.Request[IndicatorResponse[CandlestickValue, CandlestickIndicator]] {
+	return request[CandlestickValue, CandlestickIndicator](string(pattern), symbol, interval, opts)
+}
+
+// Candlestick - gets where pattern occurs in the symbol's bars, e.g. https://twelvedata.com/docs#cdlengulfing
+func (c *client) Candlestick(symbol string, interval model.Interval, pattern CandlestickPattern, opts CandlestickOptions) (IndicatorResponse[CandlestickValue, CandlestickIndicator], error) {
+	return c.CandlestickWithContext(context.Background(), symbol, interval, pattern, opts)
+}
+
+// CandlestickWithContext - same as Candlestick, but bound to ctx
+func (c *client) CandlestickWithContext(ctx context.Context, symbol string, interval model.Interval, pattern CandlestickPattern, opts CandlestickOptions) (IndicatorResponse[CandlestickValue, CandlestickIndicator], error) {
+	return indicator[CandlestickValue, CandlestickIndicator](ctx, c, string(pattern), symbol, interval, opts)
+}
+
+// Candlesticks - scans the symbol's bars for each of patterns, one request per pattern with a few in flight at once.
+// Patterns that fail are reported through a model.SymbolErrors keyed by pattern, alongside the responses that succeeded.
+func (c *client) Candlesticks(symbol string, interval model.Interval, patterns []CandlestickPattern, opts CandlestickOptions) (map[CandlestickPattern]IndicatorResponse[CandlestickValue, CandlestickIndicator], error) {
+	return c.CandlesticksWithContext(context.Background(), symbol, interval, patterns, opts)
+}
+
+// CandlesticksWithContext - same as Candlesticks, but bound to ctx
+func (c *client) CandlesticksWithContext(ctx context.Context, symbol string, interval model.Interval, patterns []CandlestickPattern, opts CandlestickOptions) (map[CandlestickPattern]IndicatorResponse[CandlestickValue, CandlestickIndicator], error) {
+	var mu sync.Mutex
+	responses := make(map[CandlestickPattern]IndicatorResponse[CandlestickValue, CandlestickIndicator], len(patterns))
+	patternErrors := model.SymbolErrors{}
+
+	work := make(chan CandlestickPattern)
+	var wg sync.WaitGroup
+	for w := 0; w < candlestickWorkers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for pattern := range work {
+				response, err := c.CandlestickWithContext(ctx, symbol, interval, pattern, opts)
+
+				mu.Lock()
+				if err != nil {
+					patternErrors[string(pattern)] = err
+				} else {
+					responses[pattern] = response
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+
+	seen := make(map[CandlestickPattern]struct{}, len(patterns))
+	for _, pattern := range patterns {
+		if _, ok := seen[pattern]; ok {
+			continue
+		}
+		seen[pattern] = struct{}{}
+		work <- pattern
+	}
+	close(work)
+	wg.Wait()
+
+	if len(patternErrors) > 0 {
+		return responses, patternErrors
+	}
+
+	return responses, nil
+}
@@ -0,0 +1,164 @@
+package indicators
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	engulfingBody = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"CDLENGULFING - Engulfing Pattern"}},"values":[{"datetime":"2023-08-24","open":"180.67000","high":"181.10400","low":"176.01000","close":"176.37000","volume":"54945800","cdlengulfing":"-100"},{"datetime":"2023-08-23","open":"178.52000","high":"181.55000","low":"178.33000","close":"181.12000","volume":"52722800","cdlengulfing":"0"}],"status":"ok"}`)
+	dojiBody      = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"CDLDOJI - Doji"}},"values":[{"datetime":"2023-08-24","cdldoji":"100"}],"status":"ok"}`)
+)
+
+func TestIntegrationCandlestick(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	_, err := client.Candlestick("AAPL", model.OneDay, CDLEngulfing, CandlestickOptions{})
+	if err != nil {
+		t.Log("Failed to make Candlestick request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitCandlestick(t *testing.T) {
+	var query url.Values
+	client := client{
+		c: http.DefaultClient,
+		getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+			query = u.Query()
+			if u.Path != "/cdlengulfing" {
+				return nil, errors.New("unexpected path")
+			}
+			return engulfingBody, nil
+		},
+	}
+
+	response, err := client.Candlestick("AAPL", model.OneDay, CDLEngulfing, CandlestickOptions{IndicatorOptions{IncludeOHLC: true}})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "true", query.Get("include_ohlc"))
+		assert.Equal(t, "CDLENGULFING - Engulfing Pattern", response.Meta.Indicator.Name)
+		assert.Equal(t, -100, response.Values[0].Signal)
+		assert.Equal(t, 0, response.Values[1].Signal)
+	}
+}
+
+func TestUnitCandlesticks(t *testing.T) {
+	type want struct {
+		signals  map[CandlestickPattern]int
+		failed   []CandlestickPattern
+		contains string
+	}
+
+	cases := []struct {
+		name     string
+		patterns []CandlestickPattern
+		want     want
+	}{
+		{
+			"scans every pattern",
+			[]CandlestickPattern{CDLEngulfing, CDLDoji, CDLEngulfing},
+			want{signals: map[CandlestickPattern]int{CDLEngulfing: -100, CDLDoji: 100}},
+		},
+		{
+			"keeps the patterns that succeeded",
+			[]CandlestickPattern{CDLEngulfing, CDLHammer, CDLDoji, CDLMarubozu},
+			want{
+				signals:  map[CandlestickPattern]int{CDLEngulfing: -100, CDLDoji: 100},
+				failed:   []CandlestickPattern{CDLHammer, CDLMarubozu},
+				contains: "2 symbol(s) failed: cdlhammer: failed to get; cdlmarubozu: failed to get",
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					switch u.Path {
+					case "/cdlengulfing":
+						return engulfingBody, nil
+					case "/cdldoji":
+						return dojiBody, nil
+					default:
+						return nil, errors.New("failed to get")
+					}
+				},
+			}
+
+			responses, err := client.Candlesticks("AAPL", model.OneDay, tt.patterns, CandlestickOptions{})
+			if len(tt.want.failed) > 0 {
+				var patternErrors model.SymbolErrors
+				if assert.True(t, errors.As(err, &patternErrors)) {
+					assert.Len(t, patternErrors, len(tt.want.failed))
+					for _, pattern := range tt.want.failed {
+						assert.Contains(t, patternErrors, string(pattern))
+					}
+					assert.Equal(t, tt.want.contains, err.Error())
+				}
+			} else {
+				assert.Nil(t, err)
+			}
+
+			if assert.Len(t, responses, len(tt.want.signals)) {
+				for pattern, signal := range tt.want.signals {
+					assert.Equal(t, signal, responses[pattern].Values[0].Signal)
+				}
+			}
+		})
+	}
+}
+
+func TestUnitCandlestickPatterns(t *testing.T) {
+	seen := map[CandlestickPattern]struct{}{}
+	for _, pattern := range CandlestickPatterns {
+		_, ok := seen[pattern]
+		assert.False(t, ok, "duplicate pattern %s", pattern)
+		seen[pattern] = struct{}{}
+	}
+
+	assert.Len(t, seen, 61)
+}
+
+func TestUnitCandlestickValue(t *testing.T) {
+	cases := []struct {
+		name     string
+		input    string
+		want     int
+		contains string
+	}{
+		{"reads the pattern's signal", `{"datetime":"2023-08-24","cdlhammer":"100"}`, 100, ""},
+		{"skips include_ohlc's bars", `{"datetime":"2023-08-24","open":"180.67000","high":"181.10000","low":"176.01000","close":"176.38000","volume":"54945800","cdlengulfing":"-100"}`, -100, ""},
+		{"handles a missing signal", `{"datetime":"2023-08-24","close":"176.38000"}`, 0, "got 0: []"},
+		{"handles several signals", `{"datetime":"2023-08-24","cdlhammer":"100","cdldoji":"0"}`, 0, "got 2: [cdldoji cdlhammer]"},
+		{"handles an empty signal", `{"datetime":"2023-08-24","cdlhammer":""}`, 0, "failed to parse value cdlhammer"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var value CandlestickValue
+			err := json.Unmarshal([]byte(tt.input), &value)
+			if tt.contains != "" {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, tt.want, value.Signal)
+			}
+		})
+	}
+}
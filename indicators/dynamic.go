@@ -0,0 +1,101 @@
+package indicators
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/pkg/errors"
+)
+
+// DynamicIndicator - the Indicator value for IndicatorMeta of an indicator requested by name, holding its parameters
+// as the API returned them
+type DynamicIndicator map[string]any
+
+// DynamicValue - the Indicator value for IndicatorResponse of an indicator requested by name, Values holds every
+// output line keyed by its name, leaving out lines the API has no value for
+type DynamicValue struct {
+	Datetime time.Time          `json:"datetime"`
+	Values   map[string]float64 `json:"values"`
+}
+
+// UnmarshalJSON - unmarshal's DynamicValue to a more consumable type
+func (d *DynamicValue) UnmarshalJSON(v []byte) error {
+	var value map[string]any
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	raw, _ := value["datetime"].(string)
+	dateTime, err := parseDatetime(raw)
+	if err != nil {
+		return err
+	}
+
+	values := make(map[string]float64, len(value)-1)
+	for key, raw := range value {
+		switch raw := raw.(type) {
+		case string:
+			if key == "datetime" || raw == "" {
+				continue
+			}
+
+			f, err := parseFloat(raw, key)
+			if err != nil {
+				return err
+			}
+			values[key] = f
+		case float64:
+			values[key] = raw
+		case nil:
+		default:
+			return errors.Errorf("failed to parse value %s into float", key)
+		}
+	}
+
+	d.Datetime = dateTime
+	d.Values = values
+
+	return nil
+}
+
+func (d *DynamicValue) inLocation(loc *time.Location) {
+	d.Datetime = model.InLocation(d.Datetime, loc)
+}
+
+// dynamicParams - the query parameters of an indicator requested by name, passed to the API as they are except
+// for symbol, interval and apikey which can't be overridden
+type dynamicParams map[string]string
+
+func (d dynamicParams) params(u *url.URL, urlValues url.Values) {
+	for key, value := range d {
+		if _, ok := urlValues[key]; !ok {
+			urlValues.Set(key, value)
+		}
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+func (d dynamicParams) timezone() string {
+	return d["timezone"]
+}
+
+// IndicatorRequest - builds a request for any indicator by name to queue on a batch.Batch, name is path escaped
+func IndicatorRequest(name string, symbol string, interval model.Interval, params map[string]string) batch.Request[IndicatorResponse[DynamicValue, DynamicIndicator]] {
+	return request[DynamicValue, DynamicIndicator](url.PathEscape(name), symbol, interval, dynamicParams(params))
+}
+
+// Indicator - gets any indicator by its endpoint name, e.g. "coppock", for indicators without a typed method. params
+// holds every query parameter besides symbol and interval, e.g. time_period, outputsize or timezone:
+// https://twelvedata.com/docs#technical-indicators
+func (c *client) Indicator(ctx context.Context, name string, symbol string, interval model.Interval, params map[string]string) (IndicatorResponse[DynamicValue, DynamicIndicator], error) {
+	if name == "" || url.PathEscape(name) != name {
+		return IndicatorResponse[DynamicValue, DynamicIndicator]{}, errors.Errorf("invalid indicator name '%s'", name)
+	}
+
+	return indicator[DynamicValue, DynamicIndicator](ctx, c, name, symbol, interval, dynamicParams(params))
+}
@@ -0,0 +1,92 @@
+package indicators
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	coppockBody = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"COPPOCK - Coppock Curve","series_type":"close","long_roc_period":14,"short_roc_period":11,"wma_period":10}},"values":[{"datetime":"2023-08-24","coppock":"4.21033"},{"datetime":"2023-08-23","coppock":""}],"status":"ok"}`)
+)
+
+func TestIntegrationIndicator(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	_, err := client.Indicator(context.Background(), "coppock", "AAPL", model.OneDay, nil)
+	if err != nil {
+		t.Log("Failed to make Indicator request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitIndicator(t *testing.T) {
+	var query url.Values
+	client := client{
+		c: http.DefaultClient,
+		getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+			query = u.Query()
+			if u.Path != "/coppock" {
+				return nil, errors.New("unexpected path")
+			}
+			return coppockBody, nil
+		},
+	}
+
+	newYork, err := time.LoadLocation("America/New_York")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	response, err := client.Indicator(context.Background(), "coppock", "AAPL", model.OneDay, map[string]string{
+		"outputsize":  "30",
+		"wma_period":  "10",
+		"series_type": "open",
+		"symbol":      "MSFT",
+	})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "30", query.Get("outputsize"))
+		assert.Equal(t, "10", query.Get("wma_period"))
+		assert.Equal(t, []string{"open"}, query["series_type"])
+		assert.Equal(t, []string{"AAPL"}, query["symbol"])
+
+		assert.Equal(t, "COPPOCK - Coppock Curve", response.Meta.Indicator["name"])
+		assert.Equal(t, 10.0, response.Meta.Indicator["wma_period"])
+		assert.Equal(t, time.Date(2023, 8, 24, 0, 0, 0, 0, newYork), response.Values[0].Datetime)
+		assert.Equal(t, map[string]float64{"coppock": 4.21033}, response.Values[0].Values)
+		assert.Empty(t, response.Values[1].Values)
+	}
+
+	response, err = client.Indicator(context.Background(), "coppock", "AAPL", model.OneDay, map[string]string{"timezone": "UTC"})
+	if assert.Nil(t, err) {
+		assert.Equal(t, time.UTC, response.Values[0].Datetime.Location())
+	}
+
+	for _, name := range []string{"", "time_series/../coppock", "coppock?symbol=MSFT", "coppock#"} {
+		_, err = client.Indicator(context.Background(), name, "AAPL", model.OneDay, nil)
+		if assert.NotNil(t, err) {
+			assert.Contains(t, err.Error(), "invalid indicator name")
+		}
+	}
+}
+
+func TestUnitIndicatorRequest(t *testing.T) {
+	request := IndicatorRequest("coppock", "AAPL", model.OneDay, map[string]string{"wma_period": "10"})
+
+	assert.Equal(t, "coppock", request.Endpoint)
+	assert.Equal(t, "AAPL", request.Params.Get("symbol"))
+	assert.Equal(t, "10", request.Params.Get("wma_period"))
+
+	request = IndicatorRequest("../time_series", "AAPL", model.OneDay, nil)
+	assert.Equal(t, "..%2Ftime_series", request.Endpoint)
+}
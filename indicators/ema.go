@@ -1,12 +1,13 @@
 package indicators
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/url"
 	"strconv"
 	"time"
 
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
 	"github.com/DefinitelyNotAGoat/twelvedata/model"
 	"github.com/pkg/errors"
 )
@@ -52,6 +53,10 @@ func (e *EMAValue) UnmarshalJSON(v []byte) error {
 	return nil
 }
 
+func (e *EMAValue) inLocation(loc *time.Location) {
+	e.Datetime = model.InLocation(e.Datetime, loc)
+}
+
 // EMAOptions - options for calling the twelvedata ema endpoint: https://twelvedata.com/docs#ema
 type EMAOptions struct {
 	IndicatorOptions
@@ -68,27 +73,11 @@ func (e EMAOptions) params(u *url.URL, urlValues url.Values) {
 	u.RawQuery = urlValues.Encode()
 }
 
-func ema[Response IndicatorResponse[EMAValue, EMAIndicator]](symbol string, interval model.Interval, apiKey string, getFn getFn, opts EMAOptions) (Response, error) {
-	u, err := url.Parse(fmt.Sprintf("%s/ema", baseURI))
-	if err != nil {
-		return Response{}, errors.Wrapf(err, "failed to parse base URL '%s'", baseURI)
-	}
-
-	opts.params(u, url.Values{
-		"symbol":   {symbol},
-		"interval": {string(interval)},
-		"apikey":   {apiKey},
-	})
-
-	body, err := getFn(u)
-	if err != nil {
-		return Response{}, err
-	}
-
-	var response Response
-	if err := json.Unmarshal(body, &response); err != nil {
-		return Response{}, err
-	}
+func ema(ctx context.Context, c *client, symbol string, interval model.Interval, opts EMAOptions) (IndicatorResponse[EMAValue, EMAIndicator], error) {
+	return indicator[EMAValue, EMAIndicator](ctx, c, "ema", symbol, interval, opts)
+}
 
-	return response, nil
+// EMARequest - builds a EMA request to queue on a batch.Batch
+func EMARequest(symbol string, interval model.Interval, opts EMAOptions) batch.Request[IndicatorResponse[EMAValue, EMAIndicator]] {
+	return request[EMAValue, EMAIndicator]("ema", symbol, interval, opts)
 }
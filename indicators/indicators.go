@@ -1,64 +1,228 @@
 package indicators
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
 	"time"
 
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
 	httpt "github.com/DefinitelyNotAGoat/twelvedata/http"
 	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/pkg/errors"
 )
 
-const (
-	baseURI = "https://api.twelvedata.com"
-)
-
-type getFn func(u *url.URL) ([]byte, error)
+type getFn func(ctx context.Context, u *url.URL) ([]byte, error)
 
 // Client - Exposes an interface to interact with Twelvedata's technical indicators API: https://twelvedata.com/docs#technical-indicators
 type Client interface {
 	EMA(symbol string, interval model.Interval, opts EMAOptions) (IndicatorResponse[EMAValue, EMAIndicator], error)
+	EMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts EMAOptions) (IndicatorResponse[EMAValue, EMAIndicator], error)
 	MACD(symbol string, interval model.Interval, opts MACDOptions) (IndicatorResponse[MACDValue, MACDIndicator], error)
+	MACDWithContext(ctx context.Context, symbol string, interval model.Interval, opts MACDOptions) (IndicatorResponse[MACDValue, MACDIndicator], error)
 	RSI(symbol string, interval model.Interval, opts RSIOptions) (IndicatorResponse[RSIValue, RSIIndicator], error)
+	RSIWithContext(ctx context.Context, symbol string, interval model.Interval, opts RSIOptions) (IndicatorResponse[RSIValue, RSIIndicator], error)
 	Stochastic(symbol string, interval model.Interval, opts StochasticOptions) (IndicatorResponse[StochasticValue, StochasticIndicator], error)
+	StochasticWithContext(ctx context.Context, symbol string, interval model.Interval, opts StochasticOptions) (IndicatorResponse[StochasticValue, StochasticIndicator], error)
+	SMA(symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[SMAValue, MovingAverageIndicator], error)
+	SMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[SMAValue, MovingAverageIndicator], error)
+	WMA(symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[WMAValue, MovingAverageIndicator], error)
+	WMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[WMAValue, MovingAverageIndicator], error)
+	DEMA(symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[DEMAValue, MovingAverageIndicator], error)
+	DEMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[DEMAValue, MovingAverageIndicator], error)
+	TEMA(symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[TEMAValue, MovingAverageIndicator], error)
+	TEMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[TEMAValue, MovingAverageIndicator], error)
+	KAMA(symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[KAMAValue, MovingAverageIndicator], error)
+	KAMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[KAMAValue, MovingAverageIndicator], error)
+	T3MA(symbol string, interval model.Interval, opts T3MAOptions) (IndicatorResponse[T3MAValue, T3MAIndicator], error)
+	T3MAWithContext(ctx context.Context, symbol string, interval model.Interval, opts T3MAOptions) (IndicatorResponse[T3MAValue, T3MAIndicator], error)
+	TRIMA(symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[TRIMAValue, MovingAverageIndicator], error)
+	TRIMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[TRIMAValue, MovingAverageIndicator], error)
+	HMA(symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[HMAValue, MovingAverageIndicator], error)
+	HMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[HMAValue, MovingAverageIndicator], error)
+	MA(symbol string, interval model.Interval, opts MAOptions) (IndicatorResponse[MAValue, MAIndicator], error)
+	MAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MAOptions) (IndicatorResponse[MAValue, MAIndicator], error)
+	BBANDS(symbol string, interval model.Interval, opts BBANDSOptions) (IndicatorResponse[BBANDSValue, BBANDSIndicator], error)
+	BBANDSWithContext(ctx context.Context, symbol string, interval model.Interval, opts BBANDSOptions) (IndicatorResponse[BBANDSValue, BBANDSIndicator], error)
+	ATR(symbol string, interval model.Interval, opts ATROptions) (IndicatorResponse[ATRValue, ATRIndicator], error)
+	ATRWithContext(ctx context.Context, symbol string, interval model.Interval, opts ATROptions) (IndicatorResponse[ATRValue, ATRIndicator], error)
+	NATR(symbol string, interval model.Interval, opts ATROptions) (IndicatorResponse[NATRValue, ATRIndicator], error)
+	NATRWithContext(ctx context.Context, symbol string, interval model.Interval, opts ATROptions) (IndicatorResponse[NATRValue, ATRIndicator], error)
+	Keltner(symbol string, interval model.Interval, opts KeltnerOptions) (IndicatorResponse[KeltnerValue, KeltnerIndicator], error)
+	KeltnerWithContext(ctx context.Context, symbol string, interval model.Interval, opts KeltnerOptions) (IndicatorResponse[KeltnerValue, KeltnerIndicator], error)
+	ADX(symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[ADXValue, DirectionalIndicator], error)
+	ADXWithContext(ctx context.Context, symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[ADXValue, DirectionalIndicator], error)
+	ADXR(symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[ADXRValue, DirectionalIndicator], error)
+	ADXRWithContext(ctx context.Context, symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[ADXRValue, DirectionalIndicator], error)
+	DX(symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[DXValue, DirectionalIndicator], error)
+	DXWithContext(ctx context.Context, symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[DXValue, DirectionalIndicator], error)
+	PlusDI(symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[PlusDIValue, DirectionalIndicator], error)
+	PlusDIWithContext(ctx context.Context, symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[PlusDIValue, DirectionalIndicator], error)
+	MinusDI(symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[MinusDIValue, DirectionalIndicator], error)
+	MinusDIWithContext(ctx context.Context, symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[MinusDIValue, DirectionalIndicator], error)
+	Aroon(symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[AroonValue, DirectionalIndicator], error)
+	AroonWithContext(ctx context.Context, symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[AroonValue, DirectionalIndicator], error)
+	AroonOsc(symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[AroonOscValue, DirectionalIndicator], error)
+	AroonOscWithContext(ctx context.Context, symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[AroonOscValue, DirectionalIndicator], error)
+	SuperTrend(symbol string, interval model.Interval, opts SuperTrendOptions) (IndicatorResponse[SuperTrendValue, SuperTrendIndicator], error)
+	SuperTrendWithContext(ctx context.Context, symbol string, interval model.Interval, opts SuperTrendOptions) (IndicatorResponse[SuperTrendValue, SuperTrendIndicator], error)
+	SAR(symbol string, interval model.Interval, opts SAROptions) (IndicatorResponse[SARValue, SARIndicator], error)
+	SARWithContext(ctx context.Context, symbol string, interval model.Interval, opts SAROptions) (IndicatorResponse[SARValue, SARIndicator], error)
+	OBV(symbol string, interval model.Interval, opts VolumeOptions) (IndicatorResponse[OBVValue, VolumeIndicator], error)
+	OBVWithContext(ctx context.Context, symbol string, interval model.Interval, opts VolumeOptions) (IndicatorResponse[OBVValue, VolumeIndicator], error)
+	AD(symbol string, interval model.Interval, opts VolumeOptions) (IndicatorResponse[ADValue, VolumeIndicator], error)
+	ADWithContext(ctx context.Context, symbol string, interval model.Interval, opts VolumeOptions) (IndicatorResponse[ADValue, VolumeIndicator], error)
+	ADOSC(symbol string, interval model.Interval, opts ADOSCOptions) (IndicatorResponse[ADOSCValue, ADOSCIndicator], error)
+	ADOSCWithContext(ctx context.Context, symbol string, interval model.Interval, opts ADOSCOptions) (IndicatorResponse[ADOSCValue, ADOSCIndicator], error)
+	MFI(symbol string, interval model.Interval, opts MFIOptions) (IndicatorResponse[MFIValue, MFIIndicator], error)
+	MFIWithContext(ctx context.Context, symbol string, interval model.Interval, opts MFIOptions) (IndicatorResponse[MFIValue, MFIIndicator], error)
+	VWAP(symbol string, interval model.Interval, opts VWAPOptions) (IndicatorResponse[VWAPValue, VWAPIndicator], error)
+	VWAPWithContext(ctx context.Context, symbol string, interval model.Interval, opts VWAPOptions) (IndicatorResponse[VWAPValue, VWAPIndicator], error)
+	CCI(symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[CCIValue, OscillatorIndicator], error)
+	CCIWithContext(ctx context.Context, symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[CCIValue, OscillatorIndicator], error)
+	WILLR(symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[WILLRValue, OscillatorIndicator], error)
+	WILLRWithContext(ctx context.Context, symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[WILLRValue, OscillatorIndicator], error)
+	ROC(symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[ROCValue, OscillatorIndicator], error)
+	ROCWithContext(ctx context.Context, symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[ROCValue, OscillatorIndicator], error)
+	ROCP(symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[ROCPValue, OscillatorIndicator], error)
+	ROCPWithContext(ctx context.Context, symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[ROCPValue, OscillatorIndicator], error)
+	ROCR(symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[ROCRValue, OscillatorIndicator], error)
+	ROCRWithContext(ctx context.Context, symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[ROCRValue, OscillatorIndicator], error)
+	MOM(symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[MOMValue, OscillatorIndicator], error)
+	MOMWithContext(ctx context.Context, symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[MOMValue, OscillatorIndicator], error)
+	PPO(symbol string, interval model.Interval, opts PriceOscillatorOptions) (IndicatorResponse[PPOValue, PriceOscillatorIndicator], error)
+	PPOWithContext(ctx context.Context, symbol string, interval model.Interval, opts PriceOscillatorOptions) (IndicatorResponse[PPOValue, PriceOscillatorIndicator], error)
+	APO(symbol string, interval model.Interval, opts PriceOscillatorOptions) (IndicatorResponse[APOValue, PriceOscillatorIndicator], error)
+	APOWithContext(ctx context.Context, symbol string, interval model.Interval, opts PriceOscillatorOptions) (IndicatorResponse[APOValue, PriceOscillatorIndicator], error)
+	ULTOSC(symbol string, interval model.Interval, opts ULTOSCOptions) (IndicatorResponse[ULTOSCValue, ULTOSCIndicator], error)
+	ULTOSCWithContext(ctx context.Context, symbol string, interval model.Interval, opts ULTOSCOptions) (IndicatorResponse[ULTOSCValue, ULTOSCIndicator], error)
+	StochRSI(symbol string, interval model.Interval, opts StochRSIOptions) (IndicatorResponse[StochRSIValue, StochRSIIndicator], error)
+	StochRSIWithContext(ctx context.Context, symbol string, interval model.Interval, opts StochRSIOptions) (IndicatorResponse[StochRSIValue, StochRSIIndicator], error)
+	StochF(symbol string, interval model.Interval, opts StochFOptions) (IndicatorResponse[StochFValue, StochFIndicator], error)
+	StochFWithContext(ctx context.Context, symbol string, interval model.Interval, opts StochFOptions) (IndicatorResponse[StochFValue, StochFIndicator], error)
+	Ichimoku(symbol string, interval model.Interval, opts IchimokuOptions) (IndicatorResponse[IchimokuValue, IchimokuIndicator], error)
+	IchimokuWithContext(ctx context.Context, symbol string, interval model.Interval, opts IchimokuOptions) (IndicatorResponse[IchimokuValue, IchimokuIndicator], error)
+	PivotPointsHL(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[PivotPointsHLValue, OverlayIndicator], error)
+	PivotPointsHLWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[PivotPointsHLValue, OverlayIndicator], error)
+	MidPoint(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[MidPointValue, OverlayIndicator], error)
+	MidPointWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[MidPointValue, OverlayIndicator], error)
+	MidPrice(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[MidPriceValue, OverlayIndicator], error)
+	MidPriceWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[MidPriceValue, OverlayIndicator], error)
+	HTTrendline(symbol string, interval model.Interval, opts HTTrendlineOptions) (IndicatorResponse[HTTrendlineValue, HTTrendlineIndicator], error)
+	HTTrendlineWithContext(ctx context.Context, symbol string, interval model.Interval, opts HTTrendlineOptions) (IndicatorResponse[HTTrendlineValue, HTTrendlineIndicator], error)
+	LinearReg(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegValue, OverlayIndicator], error)
+	LinearRegWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegValue, OverlayIndicator], error)
+	LinearRegAngle(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegAngleValue, OverlayIndicator], error)
+	LinearRegAngleWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegAngleValue, OverlayIndicator], error)
+	LinearRegIntercept(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegInterceptValue, OverlayIndicator], error)
+	LinearRegInterceptWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegInterceptValue, OverlayIndicator], error)
+	LinearRegSlope(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegSlopeValue, OverlayIndicator], error)
+	LinearRegSlopeWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegSlopeValue, OverlayIndicator], error)
+	TSF(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[TSFValue, OverlayIndicator], error)
+	TSFWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[TSFValue, OverlayIndicator], error)
+	Candlestick(symbol string, interval model.Interval, pattern CandlestickPattern, opts CandlestickOptions) (IndicatorResponse[CandlestickValue, CandlestickIndicator], error)
+	CandlestickWithContext(ctx context.Context, symbol string, interval model.Interval, pattern CandlestickPattern, opts CandlestickOptions) (IndicatorResponse[CandlestickValue, CandlestickIndicator], error)
+	Candlesticks(symbol string, interval model.Interval, patterns []CandlestickPattern, opts CandlestickOptions) (map[CandlestickPattern]IndicatorResponse[CandlestickValue, CandlestickIndicator], error)
+	CandlesticksWithContext(ctx context.Context, symbol string, interval model.Interval, patterns []CandlestickPattern, opts CandlestickOptions) (map[CandlestickPattern]IndicatorResponse[CandlestickValue, CandlestickIndicator], error)
+	Indicator(ctx context.Context, name string, symbol string, interval model.Interval, params map[string]string) (IndicatorResponse[DynamicValue, DynamicIndicator], error)
+	LN(symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[LNValue, MathTransformIndicator], error)
+	LNWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[LNValue, MathTransformIndicator], error)
+	LOG10(symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[LOG10Value, MathTransformIndicator], error)
+	LOG10WithContext(ctx context.Context, symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[LOG10Value, MathTransformIndicator], error)
+	SQRT(symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[SQRTValue, MathTransformIndicator], error)
+	SQRTWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[SQRTValue, MathTransformIndicator], error)
+	EXP(symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[EXPValue, MathTransformIndicator], error)
+	EXPWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[EXPValue, MathTransformIndicator], error)
+	CEIL(symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[CEILValue, MathTransformIndicator], error)
+	CEILWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[CEILValue, MathTransformIndicator], error)
+	FLOOR(symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[FLOORValue, MathTransformIndicator], error)
+	FLOORWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[FLOORValue, MathTransformIndicator], error)
+	ADD(symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[ADDValue, MathOperatorIndicator], error)
+	ADDWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[ADDValue, MathOperatorIndicator], error)
+	SUB(symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[SUBValue, MathOperatorIndicator], error)
+	SUBWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[SUBValue, MathOperatorIndicator], error)
+	MULT(symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[MULTValue, MathOperatorIndicator], error)
+	MULTWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[MULTValue, MathOperatorIndicator], error)
+	DIV(symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[DIVValue, MathOperatorIndicator], error)
+	DIVWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[DIVValue, MathOperatorIndicator], error)
+	SUM(symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[SUMValue, StatisticIndicator], error)
+	SUMWithContext(ctx context.Context, symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[SUMValue, StatisticIndicator], error)
+	MAX(symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[MAXValue, StatisticIndicator], error)
+	MAXWithContext(ctx context.Context, symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[MAXValue, StatisticIndicator], error)
+	MIN(symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[MINValue, StatisticIndicator], error)
+	MINWithContext(ctx context.Context, symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[MINValue, StatisticIndicator], error)
+	AVG(symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[AVGValue, StatisticIndicator], error)
+	AVGWithContext(ctx context.Context, symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[AVGValue, StatisticIndicator], error)
+	STDDEV(symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[STDDEVValue, StatisticIndicator], error)
+	STDDEVWithContext(ctx context.Context, symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[STDDEVValue, StatisticIndicator], error)
+	VAR(symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[VARValue, StatisticIndicator], error)
+	VARWithContext(ctx context.Context, symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[VARValue, StatisticIndicator], error)
+	BETA(symbol string, interval model.Interval, opts CorrelationOptions) (IndicatorResponse[BETAValue, CorrelationIndicator], error)
+	BETAWithContext(ctx context.Context, symbol string, interval model.Interval, opts CorrelationOptions) (IndicatorResponse[BETAValue, CorrelationIndicator], error)
+	CORREL(symbol string, interval model.Interval, opts CorrelationOptions) (IndicatorResponse[CORRELValue, CorrelationIndicator], error)
+	CORRELWithContext(ctx context.Context, symbol string, interval model.Interval, opts CorrelationOptions) (IndicatorResponse[CORRELValue, CorrelationIndicator], error)
 }
 
 type client struct {
-	apiKey string
-	c      *http.Client
-	getFn  getFn
+	apiKey  string
+	baseURL string
+	c       *http.Client
+	getFn   getFn
 }
 
 // New - returns a new Twelvedata's technical indicators Client
-func New(apiKey string, c *http.Client) Client {
+func New(apiKey string, c *http.Client, opts ...httpt.Option) Client {
+	httpClient := httpt.NewClient(c, opts...)
+
 	return &client{
-		apiKey: apiKey,
-		c:      c,
-		getFn: func(u *url.URL) ([]byte, error) {
-			return httpt.Get(u, c)
-		},
+		apiKey:  apiKey,
+		baseURL: httpClient.BaseURL(),
+		c:       c,
+		getFn:   httpClient.Get,
 	}
 }
 
 // EMA - is a generic indicator function for getting the EMA: https://twelvedata.com/docs#ema
 func (c *client) EMA(symbol string, interval model.Interval, opts EMAOptions) (IndicatorResponse[EMAValue, EMAIndicator], error) {
-	return ema(symbol, interval, c.apiKey, c.getFn, opts)
+	return c.EMAWithContext(context.Background(), symbol, interval, opts)
+}
+
+// EMAWithContext - same as EMA, but bound to ctx
+func (c *client) EMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts EMAOptions) (IndicatorResponse[EMAValue, EMAIndicator], error) {
+	return ema(ctx, c, symbol, interval, opts)
 }
 
 // MACD - is a generic indicator function for getting the MACD: https://twelvedata.com/docs#macd
 func (c *client) MACD(symbol string, interval model.Interval, opts MACDOptions) (IndicatorResponse[MACDValue, MACDIndicator], error) {
-	return macd(symbol, interval, c.apiKey, c.getFn, opts)
+	return c.MACDWithContext(context.Background(), symbol, interval, opts)
+}
+
+// MACDWithContext - same as MACD, but bound to ctx
+func (c *client) MACDWithContext(ctx context.Context, symbol string, interval model.Interval, opts MACDOptions) (IndicatorResponse[MACDValue, MACDIndicator], error) {
+	return macd(ctx, c, symbol, interval, opts)
 }
 
 // RSI - is a generic indicator function for getting the RSI: https://twelvedata.com/docs#rsi
 func (c *client) RSI(symbol string, interval model.Interval, opts RSIOptions) (IndicatorResponse[RSIValue, RSIIndicator], error) {
-	return rsi(symbol, interval, c.apiKey, c.getFn, opts)
+	return c.RSIWithContext(context.Background(), symbol, interval, opts)
+}
+
+// RSIWithContext - same as RSI, but bound to ctx
+func (c *client) RSIWithContext(ctx context.Context, symbol string, interval model.Interval, opts RSIOptions) (IndicatorResponse[RSIValue, RSIIndicator], error) {
+	return rsi(ctx, c, symbol, interval, opts)
 }
 
 // Stochastic - is a generic indicator function for getting the Stochastic: https://twelvedata.com/docs#stoch
 func (c *client) Stochastic(symbol string, interval model.Interval, opts StochasticOptions) (IndicatorResponse[StochasticValue, StochasticIndicator], error) {
-	return stochastic(symbol, interval, c.apiKey, c.getFn, opts)
+	return c.StochasticWithContext(context.Background(), symbol, interval, opts)
+}
+
+// StochasticWithContext - same as Stochastic, but bound to ctx
+func (c *client) StochasticWithContext(ctx context.Context, symbol string, interval model.Interval, opts StochasticOptions) (IndicatorResponse[StochasticValue, StochasticIndicator], error) {
+	return stochastic(ctx, c, symbol, interval, opts)
 }
 
 // IndicatorResponse - the shared response received from hitting twelvedata's indicator endpoints
@@ -68,6 +232,107 @@ type IndicatorResponse[V IndicatorValue, I Indicator] struct {
 	Status string           `json:"status"`
 }
 
+// Localize - places every value's datetime in timezone, the timezone parameter the response was requested with, or in
+// the exchange's timezone when empty. The Client and batch entries call it, a response decoded by hand stays in UTC
+func (r *IndicatorResponse[V, I]) Localize(timezone string) error {
+	loc, err := model.ResponseLocation(timezone, r.Meta.ExchangeTimezone)
+	if err != nil {
+		return err
+	}
+	r.inLocation(loc)
+
+	return nil
+}
+
+func (r *IndicatorResponse[V, I]) inLocation(loc *time.Location) {
+	for i := range r.Values {
+		if value, ok := any(&r.Values[i]).(localizer); ok {
+			value.inLocation(loc)
+		}
+	}
+}
+
+// localizer - implemented by every IndicatorValue to move its datetime into the response's timezone
+type localizer interface {
+	inLocation(loc *time.Location)
+}
+
+// every IndicatorValue must be a localizer, otherwise its datetimes silently stay in UTC
+var (
+	_ localizer = (*EMAValue)(nil)
+	_ localizer = (*MACDValue)(nil)
+	_ localizer = (*RSIValue)(nil)
+	_ localizer = (*StochasticValue)(nil)
+	_ localizer = (*SMAValue)(nil)
+	_ localizer = (*WMAValue)(nil)
+	_ localizer = (*DEMAValue)(nil)
+	_ localizer = (*TEMAValue)(nil)
+	_ localizer = (*KAMAValue)(nil)
+	_ localizer = (*T3MAValue)(nil)
+	_ localizer = (*TRIMAValue)(nil)
+	_ localizer = (*HMAValue)(nil)
+	_ localizer = (*MAValue)(nil)
+	_ localizer = (*BBANDSValue)(nil)
+	_ localizer = (*ATRValue)(nil)
+	_ localizer = (*NATRValue)(nil)
+	_ localizer = (*KeltnerValue)(nil)
+	_ localizer = (*ADXValue)(nil)
+	_ localizer = (*ADXRValue)(nil)
+	_ localizer = (*DXValue)(nil)
+	_ localizer = (*PlusDIValue)(nil)
+	_ localizer = (*MinusDIValue)(nil)
+	_ localizer = (*AroonValue)(nil)
+	_ localizer = (*AroonOscValue)(nil)
+	_ localizer = (*SuperTrendValue)(nil)
+	_ localizer = (*SARValue)(nil)
+	_ localizer = (*OBVValue)(nil)
+	_ localizer = (*ADValue)(nil)
+	_ localizer = (*ADOSCValue)(nil)
+	_ localizer = (*MFIValue)(nil)
+	_ localizer = (*VWAPValue)(nil)
+	_ localizer = (*CCIValue)(nil)
+	_ localizer = (*WILLRValue)(nil)
+	_ localizer = (*ROCValue)(nil)
+	_ localizer = (*ROCPValue)(nil)
+	_ localizer = (*ROCRValue)(nil)
+	_ localizer = (*MOMValue)(nil)
+	_ localizer = (*PPOValue)(nil)
+	_ localizer = (*APOValue)(nil)
+	_ localizer = (*ULTOSCValue)(nil)
+	_ localizer = (*StochRSIValue)(nil)
+	_ localizer = (*StochFValue)(nil)
+	_ localizer = (*IchimokuValue)(nil)
+	_ localizer = (*PivotPointsHLValue)(nil)
+	_ localizer = (*MidPointValue)(nil)
+	_ localizer = (*MidPriceValue)(nil)
+	_ localizer = (*HTTrendlineValue)(nil)
+	_ localizer = (*LinearRegValue)(nil)
+	_ localizer = (*LinearRegAngleValue)(nil)
+	_ localizer = (*LinearRegInterceptValue)(nil)
+	_ localizer = (*LinearRegSlopeValue)(nil)
+	_ localizer = (*TSFValue)(nil)
+	_ localizer = (*CandlestickValue)(nil)
+	_ localizer = (*DynamicValue)(nil)
+	_ localizer = (*LNValue)(nil)
+	_ localizer = (*LOG10Value)(nil)
+	_ localizer = (*SQRTValue)(nil)
+	_ localizer = (*EXPValue)(nil)
+	_ localizer = (*CEILValue)(nil)
+	_ localizer = (*FLOORValue)(nil)
+	_ localizer = (*ADDValue)(nil)
+	_ localizer = (*SUBValue)(nil)
+	_ localizer = (*MULTValue)(nil)
+	_ localizer = (*DIVValue)(nil)
+	_ localizer = (*SUMValue)(nil)
+	_ localizer = (*MAXValue)(nil)
+	_ localizer = (*MINValue)(nil)
+	_ localizer = (*AVGValue)(nil)
+	_ localizer = (*STDDEVValue)(nil)
+	_ localizer = (*VARValue)(nil)
+	_ localizer = (*BETAValue)(nil)
+	_ localizer = (*CORRELValue)(nil)
+)
+
 // IndicatorMeta - a shared substructure of IndicatorResponse
 type IndicatorMeta[I Indicator] struct {
 	model.Meta
@@ -76,25 +341,71 @@ type IndicatorMeta[I Indicator] struct {
 
 // IndicatorValue - A generic type representing the Values field on the shared IndicatorResponse values
 type IndicatorValue interface {
-	EMAValue | MACDValue | RSIValue | StochasticValue
+	EMAValue | MACDValue | RSIValue | StochasticValue |
+		SMAValue | WMAValue | DEMAValue | TEMAValue | KAMAValue | T3MAValue | TRIMAValue | HMAValue | MAValue |
+		BBANDSValue | ATRValue | NATRValue | KeltnerValue |
+		ADXValue | ADXRValue | DXValue | PlusDIValue | MinusDIValue | AroonValue | AroonOscValue | SuperTrendValue | SARValue |
+		OBVValue | ADValue | ADOSCValue | MFIValue | VWAPValue |
+		CCIValue | WILLRValue | ROCValue | ROCPValue | ROCRValue | MOMValue | PPOValue | APOValue | ULTOSCValue | StochRSIValue | StochFValue |
+		IchimokuValue | PivotPointsHLValue | MidPointValue | MidPriceValue | HTTrendlineValue | LinearRegValue | LinearRegAngleValue | LinearRegInterceptValue | LinearRegSlopeValue | TSFValue |
+		CandlestickValue |
+		DynamicValue |
+		LNValue | LOG10Value | SQRTValue | EXPValue | CEILValue | FLOORValue | ADDValue | SUBValue | MULTValue | DIVValue | SUMValue | MAXValue | MINValue | AVGValue | STDDEVValue | VARValue | BETAValue | CORRELValue
 }
 
 // Indicator - A generic type respresenting the Indicator field on the shared IndicatorMeta values
 type Indicator interface {
-	EMAIndicator | MACDIndicator | RSIIndicator | StochasticIndicator
+	EMAIndicator | MACDIndicator | RSIIndicator | StochasticIndicator |
+		MovingAverageIndicator | T3MAIndicator | MAIndicator |
+		BBANDSIndicator | ATRIndicator | KeltnerIndicator |
+		DirectionalIndicator | SuperTrendIndicator | SARIndicator |
+		VolumeIndicator | ADOSCIndicator | MFIIndicator | VWAPIndicator |
+		OscillatorIndicator | PriceOscillatorIndicator | ULTOSCIndicator | StochRSIIndicator | StochFIndicator |
+		IchimokuIndicator | OverlayIndicator | HTTrendlineIndicator |
+		CandlestickIndicator |
+		DynamicIndicator |
+		MathTransformIndicator | MathOperatorIndicator | StatisticIndicator | CorrelationIndicator
 }
 
+// MAType - a moving average type, as accepted by the ma_type parameters of the indicator endpoints
+type MAType string
+
+const (
+	MATypeSMA   MAType = "SMA"
+	MATypeEMA   MAType = "EMA"
+	MATypeWMA   MAType = "WMA"
+	MATypeDEMA  MAType = "DEMA"
+	MATypeTEMA  MAType = "TEMA"
+	MATypeTRIMA MAType = "TRIMA"
+	MATypeKAMA  MAType = "KAMA"
+	MATypeMAMA  MAType = "MAMA"
+	MATypeT3MA  MAType = "T3MA"
+)
+
+// SeriesType - the price series an indicator is computed from, as accepted by the series_type parameters
+type SeriesType string
+
+const (
+	SeriesTypeOpen   SeriesType = "open"
+	SeriesTypeHigh   SeriesType = "high"
+	SeriesTypeLow    SeriesType = "low"
+	SeriesTypeClose  SeriesType = "close"
+	SeriesTypeVolume SeriesType = "volume"
+)
+
 // IndicatorOptions - common url query options for all indicator based requests
 type IndicatorOptions struct {
 	Exchange    string
 	MICCode     string
 	Country     string
-	SeriesType  string
+	SeriesType  SeriesType
 	Type        string
 	OutputSize  int
 	IncludeOHLC bool
 	StartDate   *time.Time
 	EndDate     *time.Time
+	// Timezone - the timezone datetimes are returned in, model.ExchangeTimezone (the default), UTC or an IANA name
+	Timezone string
 }
 
 func (i IndicatorOptions) params(u *url.URL, urlValues url.Values) url.Values {
@@ -111,14 +422,14 @@ func (i IndicatorOptions) params(u *url.URL, urlValues url.Values) url.Values {
 	}
 
 	if i.SeriesType != "" {
-		urlValues.Add("series_type", i.SeriesType)
+		urlValues.Add("series_type", string(i.SeriesType))
 	}
 
 	if i.Type != "" {
 		urlValues.Add("type", i.Type)
 	}
 
-	if i.OutputSize == 0 {
+	if i.OutputSize > 0 {
 		urlValues.Add("outputsize", strconv.Itoa(i.OutputSize))
 	}
 
@@ -134,5 +445,103 @@ func (i IndicatorOptions) params(u *url.URL, urlValues url.Values) url.Values {
 		urlValues.Add("end_date", i.EndDate.Format(model.TimeFormatMap[model.OneHour]))
 	}
 
+	if i.Timezone != "" {
+		urlValues.Add("timezone", i.Timezone)
+	}
+
 	return urlValues
 }
+
+func (i IndicatorOptions) timezone() string {
+	return i.Timezone
+}
+
+// indicatorOptions - implemented by every indicator's options, all of which embed IndicatorOptions
+type indicatorOptions interface {
+	params(u *url.URL, urlValues url.Values)
+	timezone() string
+}
+
+// indicator - requests an indicator endpoint and decodes it into the shared IndicatorResponse
+func indicator[V IndicatorValue, I Indicator](ctx context.Context, c *client, endpoint string, symbol string, interval model.Interval, opts indicatorOptions) (IndicatorResponse[V, I], error) {
+	u, err := url.Parse(fmt.Sprintf("%s/%s", c.baseURL, endpoint))
+	if err != nil {
+		return IndicatorResponse[V, I]{}, errors.Wrapf(err, "failed to parse base URL '%s'", c.baseURL)
+	}
+
+	opts.params(u, url.Values{
+		"symbol":   {symbol},
+		"interval": {string(interval)},
+		"apikey":   {c.apiKey},
+	})
+
+	body, err := c.getFn(ctx, u)
+	if err != nil {
+		return IndicatorResponse[V, I]{}, err
+	}
+
+	var response IndicatorResponse[V, I]
+	if err := json.Unmarshal(body, &response); err != nil {
+		return IndicatorResponse[V, I]{}, err
+	}
+
+	if err := response.Localize(opts.timezone()); err != nil {
+		return IndicatorResponse[V, I]{}, err
+	}
+
+	return response, nil
+}
+
+// parseFloat - parses a string encoded indicator value, an empty string is an error rather than a silent 0
+func parseFloat(raw string, field string) (float64, error) {
+	f, err := strconv.ParseFloat(raw, 64)
+	if err != nil {
+		return 0, errors.Wrapf(err, "failed to parse value %s into float", field)
+	}
+
+	return f, nil
+}
+
+// parseVolume - parses a value computed from volume, which is empty for forex and crypto pairs like core.Value's volume
+func parseVolume(raw string, field string) (float64, error) {
+	if raw == "" {
+		return 0, nil
+	}
+
+	return parseFloat(raw, field)
+}
+
+// parseOptionalFloat - parses a value the API leaves empty until it has enough data, or that depends on an option, as nil
+func parseOptionalFloat(raw string, field string) (*float64, error) {
+	if raw == "" {
+		return nil, nil
+	}
+
+	f, err := parseFloat(raw, field)
+	if err != nil {
+		return nil, err
+	}
+
+	return &f, nil
+}
+
+// parseDatetime - parses an indicator value's datetime, in whichever format its interval uses
+func parseDatetime(raw string) (time.Time, error) {
+	dateTime, err := time.Parse(model.GetTimeFormatFromString(raw), raw)
+	if err != nil {
+		return time.Time{}, errors.Wrap(err, "failed to parse value date time into go time")
+	}
+
+	return dateTime, nil
+}
+
+// request - builds an indicator request to queue on a batch.Batch
+func request[V IndicatorValue, I Indicator](endpoint string, symbol string, interval model.Interval, opts indicatorOptions) batch.Request[IndicatorResponse[V, I]] {
+	u := &url.URL{}
+	opts.params(u, url.Values{
+		"symbol":   {symbol},
+		"interval": {string(interval)},
+	})
+
+	return batch.Request[IndicatorResponse[V, I]]{Endpoint: endpoint, Params: u.Query()}
+}
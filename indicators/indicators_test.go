@@ -0,0 +1,89 @@
+package indicators
+
+import (
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitIndicatorOptionsParams(t *testing.T) {
+	cases := []struct {
+		name  string
+		input IndicatorOptions
+		want  url.Values
+	}{
+		{
+			"leaves outputsize to the api default when unset",
+			IndicatorOptions{},
+			url.Values{},
+		},
+		{
+			"sends outputsize when set",
+			IndicatorOptions{OutputSize: 500},
+			url.Values{"outputsize": {"500"}},
+		},
+		{
+			"sends include_ohlc when set",
+			IndicatorOptions{IncludeOHLC: true},
+			url.Values{"include_ohlc": {"true"}},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.input.params(&url.URL{}, url.Values{}))
+		})
+	}
+}
+
+func TestUnitParseFloat(t *testing.T) {
+	_, err := parseFloat("", "ema")
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "failed to parse value ema into float")
+	}
+
+	value, err := parseFloat("176.38", "ema")
+	if assert.Nil(t, err) {
+		assert.Equal(t, 176.38, value)
+	}
+
+	volume, err := parseVolume("", "obv")
+	if assert.Nil(t, err) {
+		assert.Zero(t, volume)
+	}
+}
+
+func TestUnitParseOptionalFloat(t *testing.T) {
+	value, err := parseOptionalFloat("", "upper_band")
+	if assert.Nil(t, err) {
+		assert.Nil(t, value)
+	}
+
+	_, err = parseOptionalFloat("abc", "upper_band")
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "failed to parse value upper_band into float")
+	}
+
+	value, err = parseOptionalFloat("178.51877", "upper_band")
+	if assert.Nil(t, err) {
+		assert.Equal(t, ptr(178.51877), value)
+	}
+}
+
+// ptr - the address of a value, for expecting the optional fields of indicator values
+func ptr(f float64) *float64 {
+	return &f
+}
+
+// inNewYork - a day of the AAPL fixtures, whose datetimes are placed in the exchange's timezone
+func inNewYork(year int, month time.Month, day int) time.Time {
+	loc, err := model.LoadLocation("America/New_York")
+	if err != nil {
+		panic(err)
+	}
+
+	return time.Date(year, month, day, 0, 0, 0, 0, loc)
+}
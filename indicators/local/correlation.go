@@ -0,0 +1,105 @@
+package local
+
+import (
+	"math"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/core"
+	"github.com/DefinitelyNotAGoat/twelvedata/indicators"
+)
+
+// BETA - computes the beta of values against benchmark, two symbols fetched with core.Client over the same interval.
+// It is the covariance of their returns over opts.TimePeriod (default 9) divided by the variance of the benchmark's
+// returns, taking opts.SeriesType1 from values and opts.SeriesType2 from benchmark (default close). Only bars present
+// in both are used. The twelvedata beta endpoint compares two series of one symbol, so comparing two symbols is done
+// locally
+func BETA(values, benchmark []core.Value, opts indicators.CorrelationOptions) ([]indicators.BETAValue, error) {
+	bars, x, y, newestFirst, err := paired(values, benchmark, opts)
+	if err != nil {
+		return nil, err
+	}
+	period := withDefault(opts.TimePeriod, 9)
+
+	var out []indicators.BETAValue
+	for i := period; i < len(bars); i++ {
+		var sx, sy, sxy, syy float64
+		for j := i - period + 1; j <= i; j++ {
+			rx, ry := x[j]/x[j-1]-1, y[j]/y[j-1]-1
+			sx += rx
+			sy += ry
+			sxy += rx * ry
+			syy += ry * ry
+		}
+
+		var beta float64
+		n := float64(period)
+		if variance := n*syy - sy*sy; variance != 0 {
+			beta = (n*sxy - sx*sy) / variance
+		}
+		out = append(out, indicators.BETAValue{Datetime: bars[i].DateTime, Beta: beta})
+	}
+
+	return ordered(out, newestFirst), nil
+}
+
+// CORREL - computes Pearson's correlation coefficient between values and other, two symbols fetched with core.Client
+// over the same interval, over opts.TimePeriod (default 9), taking opts.SeriesType1 from values and opts.SeriesType2
+// from other (default close). Only bars present in both are used. The twelvedata correl endpoint compares two series
+// of one symbol, so comparing two symbols is done locally
+func CORREL(values, other []core.Value, opts indicators.CorrelationOptions) ([]indicators.CORRELValue, error) {
+	bars, x, y, newestFirst, err := paired(values, other, opts)
+	if err != nil {
+		return nil, err
+	}
+	period := withDefault(opts.TimePeriod, 9)
+
+	var out []indicators.CORRELValue
+	for i := period - 1; i < len(bars); i++ {
+		var sx, sy, sxy, sxx, syy float64
+		for j := i - period + 1; j <= i; j++ {
+			sx += x[j]
+			sy += y[j]
+			sxy += x[j] * y[j]
+			sxx += x[j] * x[j]
+			syy += y[j] * y[j]
+		}
+
+		var correl float64
+		n := float64(period)
+		if deviation := math.Sqrt((n*sxx - sx*sx) * (n*syy - sy*sy)); deviation != 0 {
+			correl = (n*sxy - sx*sy) / deviation
+		}
+		out = append(out, indicators.CORRELValue{Datetime: bars[i].DateTime, Correl: correl})
+	}
+
+	return ordered(out, newestFirst), nil
+}
+
+// paired - the bars of values that other also has, oldest first, with the series opts selects from each
+func paired(values, other []core.Value, opts indicators.CorrelationOptions) ([]core.Value, []float64, []float64, bool, error) {
+	bars, newestFirst := chronological(values)
+
+	others := make(map[int64]core.Value, len(other))
+	for _, v := range other {
+		others[v.DateTime.UnixNano()] = v
+	}
+
+	var both, matched []core.Value
+	for _, v := range bars {
+		if o, ok := others[v.DateTime.UnixNano()]; ok {
+			both = append(both, v)
+			matched = append(matched, o)
+		}
+	}
+
+	x, err := series(both, opts.SeriesType1)
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+
+	y, err := series(matched, opts.SeriesType2)
+	if err != nil {
+		return nil, nil, nil, false, err
+	}
+
+	return both, x, y, newestFirst, nil
+}
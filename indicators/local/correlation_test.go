@@ -0,0 +1,58 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/indicators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitBETA(t *testing.T) {
+	values, benchmark := bars(100, 120, 96, 115.2), bars(100, 110, 99, 108.9)
+
+	out, err := BETA(values, benchmark, indicators.CorrelationOptions{TimePeriod: 3})
+	if assert.Nil(t, err) && assert.Len(t, out, 1) {
+		assert.Equal(t, values[3].DateTime, out[0].Datetime)
+		assert.InDelta(t, 2, out[0].Beta, 1e-9)
+	}
+
+	out, err = BETA(newestFirst(benchmark), newestFirst(values), indicators.CorrelationOptions{TimePeriod: 3})
+	if assert.Nil(t, err) && assert.Len(t, out, 1) {
+		assert.InDelta(t, 0.5, out[0].Beta, 1e-9)
+	}
+
+	out, err = BETA(values, benchmark[1:], indicators.CorrelationOptions{TimePeriod: 3})
+	if assert.Nil(t, err) {
+		assert.Empty(t, out)
+	}
+
+	_, err = BETA(values, benchmark, indicators.CorrelationOptions{SeriesType2: "hl2"})
+	assert.EqualError(t, err, "unsupported series type 'hl2'")
+}
+
+func TestUnitCORREL(t *testing.T) {
+	values := bars(1, 2, 3, 4)
+
+	out, err := CORREL(values, bars(2, 4, 6, 8), indicators.CorrelationOptions{TimePeriod: 3})
+	if assert.Nil(t, err) && assert.Len(t, out, 2) {
+		assert.Equal(t, values[2].DateTime, out[0].Datetime)
+		assert.InDelta(t, 1, out[0].Correl, 1e-9)
+		assert.InDelta(t, 1, out[1].Correl, 1e-9)
+	}
+
+	out, err = CORREL(newestFirst(values), bars(8, 6, 4, 2), indicators.CorrelationOptions{TimePeriod: 3})
+	if assert.Nil(t, err) && assert.Len(t, out, 2) {
+		assert.Equal(t, values[3].DateTime, out[0].Datetime)
+		assert.InDelta(t, -1, out[0].Correl, 1e-9)
+	}
+
+	out, err = CORREL(values, bars(2, 4, 6, 8)[1:], indicators.CorrelationOptions{TimePeriod: 3})
+	if assert.Nil(t, err) && assert.Len(t, out, 1) {
+		assert.Equal(t, values[3].DateTime, out[0].Datetime)
+	}
+
+	out, err = CORREL(values, bars(5, 5, 5, 5), indicators.CorrelationOptions{TimePeriod: 3})
+	if assert.Nil(t, err) && assert.Len(t, out, 2) {
+		assert.Equal(t, 0.0, out[0].Correl)
+	}
+}
@@ -0,0 +1,25 @@
+package local
+
+import (
+	"github.com/DefinitelyNotAGoat/twelvedata/core"
+	"github.com/DefinitelyNotAGoat/twelvedata/indicators"
+)
+
+// EMA - computes the EMA from values, using opts.TimePeriod (default 9) and opts.SeriesType (default close)
+func EMA(values []core.Value, opts indicators.EMAOptions) ([]indicators.EMAValue, error) {
+	bars, newestFirst := chronological(values)
+	period := withDefault(opts.TimePeriod, 9)
+
+	in, err := series(bars, opts.SeriesType)
+	if err != nil {
+		return nil, err
+	}
+	averages := ema(in, period)
+
+	var out []indicators.EMAValue
+	for i := period - 1; i < len(bars); i++ {
+		out = append(out, indicators.EMAValue{Datetime: bars[i].DateTime, Ema: averages[i]})
+	}
+
+	return ordered(out, newestFirst), nil
+}
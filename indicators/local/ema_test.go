@@ -0,0 +1,54 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/indicators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitEMA(t *testing.T) {
+	values := bars(1, 2, 3, 4, 5)
+
+	cases := []struct {
+		name   string
+		opts   indicators.EMAOptions
+		want   []float64
+		errMsg string
+	}{
+		{"seeds with the simple average", indicators.EMAOptions{TimePeriod: 3}, []float64{2, 3, 4}, ""},
+		{"uses the series type", indicators.EMAOptions{TimePeriod: 3, IndicatorOptions: indicators.IndicatorOptions{SeriesType: indicators.SeriesTypeHigh}}, []float64{3, 4, 5}, ""},
+		{"is empty without enough bars", indicators.EMAOptions{}, nil, ""},
+		{"rejects an unknown series type", indicators.EMAOptions{IndicatorOptions: indicators.IndicatorOptions{SeriesType: "hl2"}}, nil, "unsupported series type"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := EMA(values, tt.opts)
+			if tt.errMsg != "" {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.errMsg)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) && assert.Len(t, out, len(tt.want)) {
+				for i, want := range tt.want {
+					assert.InDelta(t, want, out[i].Ema, 1e-9)
+					assert.Equal(t, values[len(values)-len(tt.want)+i].DateTime, out[i].Datetime)
+				}
+			}
+		})
+	}
+}
+
+func TestUnitEMANewestFirst(t *testing.T) {
+	values := bars(1, 2, 3, 4, 5)
+
+	out, err := EMA(newestFirst(values), indicators.EMAOptions{TimePeriod: 3})
+	if assert.Nil(t, err) && assert.Len(t, out, 3) {
+		assert.Equal(t, values[4].DateTime, out[0].Datetime)
+		assert.InDelta(t, 4, out[0].Ema, 1e-9)
+		assert.InDelta(t, 2, out[2].Ema, 1e-9)
+	}
+}
@@ -0,0 +1,142 @@
+// Package local computes technical indicators from time series bars already fetched with core.Client, without
+// spending API credits. Results are shaped like the values returned by indicators.Client, so they can be used
+// interchangeably or cross-checked against the remote endpoints.
+package local
+
+import (
+	"sort"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/core"
+	"github.com/DefinitelyNotAGoat/twelvedata/indicators"
+	"github.com/pkg/errors"
+)
+
+// chronological - returns values sorted oldest first, and whether the input was newest first like the API returns it
+func chronological(values []core.Value) ([]core.Value, bool) {
+	sorted := make([]core.Value, len(values))
+	copy(sorted, values)
+
+	newestFirst := len(values) > 1 && values[0].DateTime.After(values[len(values)-1].DateTime)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].DateTime.Before(sorted[j].DateTime)
+	})
+
+	return sorted, newestFirst
+}
+
+// ordered - puts computed values back into the order of the input bars
+func ordered[V any](values []V, newestFirst bool) []V {
+	if newestFirst {
+		for i, j := 0, len(values)-1; i < j; i, j = i+1, j-1 {
+			values[i], values[j] = values[j], values[i]
+		}
+	}
+
+	return values
+}
+
+// series - extracts the price series named by seriesType, the same names accepted by the API's series_type
+func series(values []core.Value, seriesType indicators.SeriesType) ([]float64, error) {
+	if seriesType == "" {
+		seriesType = indicators.SeriesTypeClose
+	}
+
+	out := make([]float64, len(values))
+	for i, v := range values {
+		switch seriesType {
+		case indicators.SeriesTypeOpen:
+			out[i] = v.Open
+		case indicators.SeriesTypeHigh:
+			out[i] = v.High
+		case indicators.SeriesTypeLow:
+			out[i] = v.Low
+		case indicators.SeriesTypeClose:
+			out[i] = v.Close
+		case indicators.SeriesTypeVolume:
+			out[i] = v.Volume
+		default:
+			return nil, errors.Errorf("unsupported series type '%s'", seriesType)
+		}
+	}
+
+	return out, nil
+}
+
+// sma - the simple moving average of in, aligned to in with the first period-1 entries unset
+func sma(in []float64, period int) []float64 {
+	out := make([]float64, len(in))
+	var sum float64
+	for i, v := range in {
+		sum += v
+		if i >= period {
+			sum -= in[i-period]
+		}
+		if i >= period-1 {
+			out[i] = sum / float64(period)
+		}
+	}
+
+	return out
+}
+
+// ema - the exponential moving average of in, seeded with the simple average of the first period entries
+func ema(in []float64, period int) []float64 {
+	out := make([]float64, len(in))
+	if len(in) < period {
+		return out
+	}
+
+	k := 2 / float64(period+1)
+	for i := 0; i < period; i++ {
+		out[period-1] += in[i] / float64(period)
+	}
+	for i := period; i < len(in); i++ {
+		out[i] = (in[i]-out[i-1])*k + out[i-1]
+	}
+
+	return out
+}
+
+// movingAverage - the moving average named by maType, as accepted by the API's *_ma_type parameters
+func movingAverage(in []float64, period int, maType string) ([]float64, error) {
+	switch maType {
+	case "", "SMA":
+		return sma(in, period), nil
+	case "EMA":
+		return ema(in, period), nil
+	default:
+		return nil, errors.Errorf("unsupported moving average type '%s'", maType)
+	}
+}
+
+// highest - the highest entry of in over the period ending at i
+func highest(in []float64, i, period int) float64 {
+	max := in[i]
+	for j := i - period + 1; j < i; j++ {
+		if in[j] > max {
+			max = in[j]
+		}
+	}
+
+	return max
+}
+
+// lowest - the lowest entry of in over the period ending at i
+func lowest(in []float64, i, period int) float64 {
+	min := in[i]
+	for j := i - period + 1; j < i; j++ {
+		if in[j] < min {
+			min = in[j]
+		}
+	}
+
+	return min
+}
+
+func withDefault(value, def int) int {
+	if value > 0 {
+		return value
+	}
+
+	return def
+}
@@ -0,0 +1,35 @@
+package local
+
+import (
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/core"
+)
+
+// bars - builds daily bars, oldest first, from closes with a high and low one either side of the close
+func bars(closes ...float64) []core.Value {
+	start := time.Date(2023, 8, 1, 0, 0, 0, 0, time.UTC)
+
+	values := make([]core.Value, len(closes))
+	for i, c := range closes {
+		values[i] = core.Value{
+			DateTime: start.AddDate(0, 0, i),
+			Open:     c,
+			High:     c + 1,
+			Low:      c - 1,
+			Close:    c,
+		}
+	}
+
+	return values
+}
+
+// newestFirst - reverses bars into the order the API returns them
+func newestFirst(values []core.Value) []core.Value {
+	reversed := make([]core.Value, len(values))
+	for i, v := range values {
+		reversed[len(values)-1-i] = v
+	}
+
+	return reversed
+}
@@ -0,0 +1,47 @@
+package local
+
+import (
+	"github.com/DefinitelyNotAGoat/twelvedata/core"
+	"github.com/DefinitelyNotAGoat/twelvedata/indicators"
+)
+
+// MACD - computes the MACD from values, using opts.FastPeriod (default 12), opts.SlowPeriod (default 26),
+// opts.SignalPeriod (default 9) and opts.SeriesType (default close)
+func MACD(values []core.Value, opts indicators.MACDOptions) ([]indicators.MACDValue, error) {
+	bars, newestFirst := chronological(values)
+	fastPeriod := withDefault(opts.FastPeriod, 12)
+	slowPeriod := withDefault(opts.SlowPeriod, 26)
+	signalPeriod := withDefault(opts.SignalPeriod, 9)
+
+	in, err := series(bars, opts.SeriesType)
+	if err != nil {
+		return nil, err
+	}
+
+	start := slowPeriod - 1
+	if fastPeriod > slowPeriod {
+		start = fastPeriod - 1
+	}
+	if len(in) <= start {
+		return nil, nil
+	}
+
+	fast, slow := ema(in, fastPeriod), ema(in, slowPeriod)
+	macd := make([]float64, len(in)-start)
+	for i := range macd {
+		macd[i] = fast[start+i] - slow[start+i]
+	}
+	signal := ema(macd, signalPeriod)
+
+	var out []indicators.MACDValue
+	for i := signalPeriod - 1; i < len(macd); i++ {
+		out = append(out, indicators.MACDValue{
+			Datetime:   bars[start+i].DateTime,
+			Macd:       macd[i],
+			MacdSignal: signal[i],
+			MacdHist:   macd[i] - signal[i],
+		})
+	}
+
+	return ordered(out, newestFirst), nil
+}
@@ -0,0 +1,27 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/indicators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitMACD(t *testing.T) {
+	values := bars(1, 2, 3, 4, 5, 6, 7)
+
+	out, err := MACD(values, indicators.MACDOptions{FastPeriod: 2, SlowPeriod: 4, SignalPeriod: 2})
+	if assert.Nil(t, err) && assert.Len(t, out, 3) {
+		// a steady trend keeps the fast average 1 above the slow one
+		for i, value := range out {
+			assert.Equal(t, values[4+i].DateTime, value.Datetime)
+			assert.InDelta(t, 1, value.Macd, 1e-9)
+			assert.InDelta(t, 1, value.MacdSignal, 1e-9)
+			assert.InDelta(t, 0, value.MacdHist, 1e-9)
+		}
+	}
+
+	out, err = MACD(values, indicators.MACDOptions{})
+	assert.Nil(t, err)
+	assert.Empty(t, out)
+}
@@ -0,0 +1,50 @@
+package local
+
+import (
+	"github.com/DefinitelyNotAGoat/twelvedata/core"
+	"github.com/DefinitelyNotAGoat/twelvedata/indicators"
+)
+
+// RSI - computes the RSI from values with Wilder's smoothing, using opts.TimePeriod (default 14) and opts.SeriesType
+// (default close)
+func RSI(values []core.Value, opts indicators.RSIOptions) ([]indicators.RSIValue, error) {
+	bars, newestFirst := chronological(values)
+	period := withDefault(opts.TimePeriod, 14)
+
+	in, err := series(bars, opts.SeriesType)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []indicators.RSIValue
+	var gain, loss float64
+	for i := 1; i < len(in); i++ {
+		var up, down float64
+		if change := in[i] - in[i-1]; change > 0 {
+			up = change
+		} else {
+			down = -change
+		}
+
+		if i <= period {
+			gain += up / float64(period)
+			loss += down / float64(period)
+			if i < period {
+				continue
+			}
+		} else {
+			gain = (gain*float64(period-1) + up) / float64(period)
+			loss = (loss*float64(period-1) + down) / float64(period)
+		}
+
+		rsi := 100.0
+		if loss != 0 {
+			rsi = 100 - 100/(1+gain/loss)
+		} else if gain == 0 {
+			rsi = 50
+		}
+		out = append(out, indicators.RSIValue{Datetime: bars[i].DateTime, Rsi: rsi})
+	}
+
+	return ordered(out, newestFirst), nil
+}
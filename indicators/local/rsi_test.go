@@ -0,0 +1,32 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/indicators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitRSI(t *testing.T) {
+	cases := []struct {
+		name  string
+		input []float64
+		want  []float64
+	}{
+		{"is 100 when only rising", []float64{1, 2, 3, 4}, []float64{100}},
+		{"is 0 when only falling", []float64{4, 3, 2, 1}, []float64{0}},
+		{"averages the first period", []float64{1, 3, 2, 4}, []float64{80}},
+		{"smooths with wilder's method", []float64{1, 3, 2, 4, 3}, []float64{80, 100 - 100/2.6}},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			out, err := RSI(bars(tt.input...), indicators.RSIOptions{TimePeriod: 3})
+			if assert.Nil(t, err) && assert.Len(t, out, len(tt.want)) {
+				for i, want := range tt.want {
+					assert.InDelta(t, want, out[i].Rsi, 1e-9)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package local
+
+import (
+	"github.com/DefinitelyNotAGoat/twelvedata/core"
+	"github.com/DefinitelyNotAGoat/twelvedata/indicators"
+)
+
+// Stochastic - computes the Stochastic from values, using opts.FastKPeriod (default 14), opts.SlowKPeriod (default 1),
+// opts.SlowDPeriod (default 3) and the SMA or EMA opts.SlowKMAType and opts.SlowDMAType (default SMA)
+func Stochastic(values []core.Value, opts indicators.StochasticOptions) ([]indicators.StochasticValue, error) {
+	bars, newestFirst := chronological(values)
+	fastKPeriod := withDefault(opts.FastKPeriod, 14)
+	slowKPeriod := withDefault(opts.SlowKPeriod, 1)
+	slowDPeriod := withDefault(opts.SlowDPeriod, 3)
+
+	start := fastKPeriod - 1
+	if len(bars) < start+slowKPeriod {
+		return nil, nil
+	}
+
+	high, _ := series(bars, indicators.SeriesTypeHigh)
+	low, _ := series(bars, indicators.SeriesTypeLow)
+	fastK := make([]float64, len(bars)-start)
+	for i := range fastK {
+		highestHigh, lowestLow := highest(high, start+i, fastKPeriod), lowest(low, start+i, fastKPeriod)
+		if highestHigh != lowestLow {
+			fastK[i] = 100 * (bars[start+i].Close - lowestLow) / (highestHigh - lowestLow)
+		}
+	}
+
+	slowK, err := movingAverage(fastK, slowKPeriod, opts.SlowKMAType)
+	if err != nil {
+		return nil, err
+	}
+	slowK = slowK[slowKPeriod-1:]
+	start += slowKPeriod - 1
+
+	slowD, err := movingAverage(slowK, slowDPeriod, opts.SlowDMAType)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []indicators.StochasticValue
+	for i := slowDPeriod - 1; i < len(slowK); i++ {
+		out = append(out, indicators.StochasticValue{Datetime: bars[start+i].DateTime, SlowK: slowK[i], SlowD: slowD[i]})
+	}
+
+	return ordered(out, newestFirst), nil
+}
@@ -0,0 +1,46 @@
+package local
+
+import (
+	"testing"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/indicators"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitStochastic(t *testing.T) {
+	cases := []struct {
+		name   string
+		opts   indicators.StochasticOptions
+		want   [][2]float64
+		errMsg string
+	}{
+		{"uses the defaults", indicators.StochasticOptions{}, nil, ""},
+		{
+			"smooths fast k into slow k and d",
+			indicators.StochasticOptions{FastKPeriod: 2, SlowKPeriod: 2, SlowDPeriod: 2},
+			[][2]float64{{50, 58.333333333333336}, {50, 50}},
+			"",
+		},
+		{"rejects an unknown moving average", indicators.StochasticOptions{FastKPeriod: 2, SlowKMAType: "KAMA"}, nil, "unsupported moving average type"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// fast k over 2 bars: 66.67, 66.67, 33.33, 66.67
+			out, err := Stochastic(bars(1, 2, 3, 2, 3), tt.opts)
+			if tt.errMsg != "" {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.errMsg)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) && assert.Len(t, out, len(tt.want)) {
+				for i, want := range tt.want {
+					assert.InDelta(t, want[0], out[i].SlowK, 1e-9)
+					assert.InDelta(t, want[1], out[i].SlowD, 1e-9)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package local
+
+import (
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/core"
+	"github.com/DefinitelyNotAGoat/twelvedata/indicators"
+)
+
+// DonchianOptions - options for Donchian
+type DonchianOptions struct {
+	// TimePeriod - the number of bars the channel spans, defaults to 20
+	TimePeriod int
+}
+
+// DonchianValue - a Donchian Channels value computed by Donchian
+type DonchianValue struct {
+	Datetime   time.Time `json:"datetime"`
+	UpperBand  float64   `json:"upper_band"`
+	MiddleBand float64   `json:"middle_band"`
+	LowerBand  float64   `json:"lower_band"`
+}
+
+// Donchian - computes the Donchian Channels from values, using opts.TimePeriod (default 20). Twelvedata has no
+// donchian endpoint, so unlike the other indicators they can only be computed locally
+func Donchian(values []core.Value, opts DonchianOptions) ([]DonchianValue, error) {
+	bars, newestFirst := chronological(values)
+	period := withDefault(opts.TimePeriod, 20)
+
+	high, err := series(bars, indicators.SeriesTypeHigh)
+	if err != nil {
+		return nil, err
+	}
+
+	low, err := series(bars, indicators.SeriesTypeLow)
+	if err != nil {
+		return nil, err
+	}
+
+	var out []DonchianValue
+	for i := period - 1; i < len(bars); i++ {
+		upper, lower := highest(high, i, period), lowest(low, i, period)
+		out = append(out, DonchianValue{
+			Datetime:   bars[i].DateTime,
+			UpperBand:  upper,
+			MiddleBand: (upper + lower) / 2,
+			LowerBand:  lower,
+		})
+	}
+
+	return ordered(out, newestFirst), nil
+}
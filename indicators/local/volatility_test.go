@@ -0,0 +1,36 @@
+package local
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitDonchian(t *testing.T) {
+	out, err := Donchian(bars(1, 5, 3, 2), DonchianOptions{TimePeriod: 3})
+	if assert.Nil(t, err) && assert.Len(t, out, 2) {
+		assert.Equal(t, DonchianValue{Datetime: out[0].Datetime, UpperBand: 6, MiddleBand: 3, LowerBand: 0}, out[0])
+		assert.Equal(t, DonchianValue{Datetime: out[1].Datetime, UpperBand: 6, MiddleBand: 3.5, LowerBand: 1}, out[1])
+	}
+
+	out, err = Donchian(newestFirst(bars(1, 5, 3, 2)), DonchianOptions{TimePeriod: 3})
+	if assert.Nil(t, err) && assert.Len(t, out, 2) {
+		assert.Equal(t, 3.5, out[0].MiddleBand)
+	}
+}
+
+func TestUnitDonchianValueJSON(t *testing.T) {
+	out, err := Donchian(bars(1, 5, 3, 2), DonchianOptions{TimePeriod: 3})
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	b, err := json.Marshal(out)
+	if assert.Nil(t, err) {
+		var decoded []DonchianValue
+		if assert.Nil(t, json.Unmarshal(b, &decoded)) {
+			assert.Equal(t, out, decoded)
+		}
+	}
+}
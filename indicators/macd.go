@@ -1,12 +1,13 @@
 package indicators
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/url"
 	"strconv"
 	"time"
 
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
 	"github.com/DefinitelyNotAGoat/twelvedata/model"
 	"github.com/pkg/errors"
 )
@@ -69,6 +70,10 @@ func (m *MACDValue) UnmarshalJSON(v []byte) error {
 	return nil
 }
 
+func (m *MACDValue) inLocation(loc *time.Location) {
+	m.Datetime = model.InLocation(m.Datetime, loc)
+}
+
 // MACDOptions - options for calling the twelvedata macd endpoint: https://twelvedata.com/docs#macd
 type MACDOptions struct {
 	IndicatorOptions
@@ -95,27 +100,11 @@ func (m MACDOptions) params(u *url.URL, urlValues url.Values) {
 	u.RawQuery = urlValues.Encode()
 }
 
-func macd[Response IndicatorResponse[MACDValue, MACDIndicator]](symbol string, interval model.Interval, apiKey string, getFn getFn, opts MACDOptions) (Response, error) {
-	u, err := url.Parse(fmt.Sprintf("%s/macd", baseURI))
-	if err != nil {
-		return Response{}, errors.Wrapf(err, "failed to parse base URL '%s'", baseURI)
-	}
-
-	opts.params(u, url.Values{
-		"symbol":   {symbol},
-		"interval": {string(interval)},
-		"apikey":   {apiKey},
-	})
-
-	body, err := getFn(u)
-	if err != nil {
-		return Response{}, err
-	}
-
-	var response Response
-	if err := json.Unmarshal(body, &response); err != nil {
-		return Response{}, err
-	}
+func macd(ctx context.Context, c *client, symbol string, interval model.Interval, opts MACDOptions) (IndicatorResponse[MACDValue, MACDIndicator], error) {
+	return indicator[MACDValue, MACDIndicator](ctx, c, "macd", symbol, interval, opts)
+}
 
-	return response, nil
+// MACDRequest - builds a MACD request to queue on a batch.Batch
+func MACDRequest(symbol string, interval model.Interval, opts MACDOptions) batch.Request[IndicatorResponse[MACDValue, MACDIndicator]] {
+	return request[MACDValue, MACDIndicator]("macd", symbol, interval, opts)
 }
@@ -1,11 +1,13 @@
 package indicators
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/url"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/DefinitelyNotAGoat/twelvedata/model"
 	"github.com/stretchr/testify/assert"
@@ -50,7 +52,7 @@ func TestUnitMACD(t *testing.T) {
 		{
 			"handles failure to get",
 			input{
-				getFn: func(u *url.URL) ([]byte, error) {
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
 					return nil, errors.New("failed to get")
 				},
 			},
@@ -62,7 +64,7 @@ func TestUnitMACD(t *testing.T) {
 		{
 			"is successful",
 			input{
-				getFn: func(u *url.URL) ([]byte, error) {
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
 					return macdBody, nil
 				},
 			},
@@ -90,3 +92,28 @@ func TestUnitMACD(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitMACDTimezone(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	client := client{
+		c: http.DefaultClient,
+		getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+			return macdBody, nil
+		},
+	}
+
+	response, err := client.MACD("AAPL", model.OneMin, MACDOptions{})
+	if assert.Nil(t, err) {
+		assert.Equal(t, time.Date(2023, 8, 23, 15, 59, 0, 0, newYork), response.Values[0].Datetime)
+	}
+
+	response, err = client.MACD("AAPL", model.OneMin, MACDOptions{IndicatorOptions: IndicatorOptions{Timezone: "Asia/Tokyo"}})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "Asia/Tokyo", response.Values[0].Datetime.Location().String())
+		assert.Equal(t, 15, response.Values[0].Datetime.Hour())
+	}
+}
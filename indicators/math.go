@@ -0,0 +1,1036 @@
+package indicators
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+)
+
+// MathTransformIndicator - the Indicator value for IndicatorMeta shared by the math transforms
+type MathTransformIndicator struct {
+	Name       string `json:"name"`
+	SeriesType string `json:"series_type"`
+}
+
+// MathOperatorIndicator - the Indicator value for IndicatorMeta shared by the math operators
+type MathOperatorIndicator struct {
+	Name        string `json:"name"`
+	SeriesType1 string `json:"series_type_1"`
+	SeriesType2 string `json:"series_type_2"`
+}
+
+// StatisticIndicator - the Indicator value for IndicatorMeta shared by the statistic functions over a time period
+type StatisticIndicator struct {
+	Name       string `json:"name"`
+	SeriesType string `json:"series_type"`
+	TimePeriod int    `json:"time_period"`
+}
+
+// CorrelationIndicator - the Indicator value for IndicatorMeta shared by BETA and CORREL
+type CorrelationIndicator struct {
+	Name        string `json:"name"`
+	SeriesType1 string `json:"series_type_1"`
+	SeriesType2 string `json:"series_type_2"`
+	TimePeriod  int    `json:"time_period"`
+}
+
+// MathTransformOptions - options for calling the twelvedata math transform endpoints, e.g.
+// https://twelvedata.com/docs#ln
+type MathTransformOptions struct {
+	IndicatorOptions
+}
+
+func (m MathTransformOptions) params(u *url.URL, urlValues url.Values) {
+	u.RawQuery = m.IndicatorOptions.params(u, urlValues).Encode()
+}
+
+// MathOperatorOptions - options for calling the twelvedata math operator endpoints, which combine two of the symbol's
+// series, e.g. https://twelvedata.com/docs#add
+type MathOperatorOptions struct {
+	IndicatorOptions
+	SeriesType1 SeriesType
+	SeriesType2 SeriesType
+}
+
+func (m MathOperatorOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = m.IndicatorOptions.params(u, urlValues)
+
+	if m.SeriesType1 != "" {
+		urlValues.Add("series_type_1", string(m.SeriesType1))
+	}
+
+	if m.SeriesType2 != "" {
+		urlValues.Add("series_type_2", string(m.SeriesType2))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// StatisticOptions - options for calling the twelvedata statistic endpoints over a time period, e.g.
+// https://twelvedata.com/docs#sum
+type StatisticOptions struct {
+	IndicatorOptions
+	TimePeriod int
+}
+
+func (s StatisticOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = s.IndicatorOptions.params(u, urlValues)
+
+	if s.TimePeriod > 0 {
+		urlValues.Add("time_period", strconv.Itoa(s.TimePeriod))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// CorrelationOptions - options for calling the twelvedata beta and correl endpoints: https://twelvedata.com/docs#beta.
+// The endpoints compare two series of the same symbol, to compare two symbols see local.BETA and local.CORREL
+type CorrelationOptions struct {
+	IndicatorOptions
+	TimePeriod  int
+	SeriesType1 SeriesType
+	SeriesType2 SeriesType
+}
+
+func (c CorrelationOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = c.IndicatorOptions.params(u, urlValues)
+
+	if c.TimePeriod > 0 {
+		urlValues.Add("time_period", strconv.Itoa(c.TimePeriod))
+	}
+
+	if c.SeriesType1 != "" {
+		urlValues.Add("series_type_1", string(c.SeriesType1))
+	}
+
+	if c.SeriesType2 != "" {
+		urlValues.Add("series_type_2", string(c.SeriesType2))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// LNValue - the Indicator value for IndicatorResponse specific for LN
+type LNValue struct {
+	Datetime time.Time `json:"datetime"`
+	Ln       float64   `json:"ln"`
+}
+
+// UnmarshalJSON - unmarshal's LNValue to a more consumable type
+func (l *LNValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Ln       string `json:"ln"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	ln, err := parseFloat(value.Ln, "ln")
+	if err != nil {
+		return err
+	}
+
+	l.Datetime = dateTime
+	l.Ln = ln
+
+	return nil
+}
+
+func (l *LNValue) inLocation(loc *time.Location) {
+	l.Datetime = model.InLocation(l.Datetime, loc)
+}
+
+// LNRequest - builds a LN request to queue on a batch.Batch
+func LNRequest(symbol string, interval model.Interval, opts MathTransformOptions) batch.Request[IndicatorResponse[LNValue, MathTransformIndicator]] {
+	return request[LNValue, MathTransformIndicator]("ln", symbol, interval, opts)
+}
+
+// LOG10Value - the Indicator value for IndicatorResponse specific for LOG10
+type LOG10Value struct {
+	Datetime time.Time `json:"datetime"`
+	Log10    float64   `json:"log10"`
+}
+
+// UnmarshalJSON - unmarshal's LOG10Value to a more consumable type
+func (l *LOG10Value) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Log10    string `json:"log10"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	log10, err := parseFloat(value.Log10, "log10")
+	if err != nil {
+		return err
+	}
+
+	l.Datetime = dateTime
+	l.Log10 = log10
+
+	return nil
+}
+
+func (l *LOG10Value) inLocation(loc *time.Location) {
+	l.Datetime = model.InLocation(l.Datetime, loc)
+}
+
+// LOG10Request - builds a LOG10 request to queue on a batch.Batch
+func LOG10Request(symbol string, interval model.Interval, opts MathTransformOptions) batch.Request[IndicatorResponse[LOG10Value, MathTransformIndicator]] {
+	return request[LOG10Value, MathTransformIndicator]("log10", symbol, interval, opts)
+}
+
+// SQRTValue - the Indicator value for IndicatorResponse specific for SQRT
+type SQRTValue struct {
+	Datetime time.Time `json:"datetime"`
+	Sqrt     float64   `json:"sqrt"`
+}
+
+// UnmarshalJSON - unmarshal's SQRTValue to a more consumable type
+func (s *SQRTValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Sqrt     string `json:"sqrt"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	sqrt, err := parseFloat(value.Sqrt, "sqrt")
+	if err != nil {
+		return err
+	}
+
+	s.Datetime = dateTime
+	s.Sqrt = sqrt
+
+	return nil
+}
+
+func (s *SQRTValue) inLocation(loc *time.Location) {
+	s.Datetime = model.InLocation(s.Datetime, loc)
+}
+
+// SQRTRequest - builds a SQRT request to queue on a batch.Batch
+func SQRTRequest(symbol string, interval model.Interval, opts MathTransformOptions) batch.Request[IndicatorResponse[SQRTValue, MathTransformIndicator]] {
+	return request[SQRTValue, MathTransformIndicator]("sqrt", symbol, interval, opts)
+}
+
+// EXPValue - the Indicator value for IndicatorResponse specific for EXP
+type EXPValue struct {
+	Datetime time.Time `json:"datetime"`
+	Exp      float64   `json:"exp"`
+}
+
+// UnmarshalJSON - unmarshal's EXPValue to a more consumable type
+func (e *EXPValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Exp      string `json:"exp"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	exp, err := parseFloat(value.Exp, "exp")
+	if err != nil {
+		return err
+	}
+
+	e.Datetime = dateTime
+	e.Exp = exp
+
+	return nil
+}
+
+func (e *EXPValue) inLocation(loc *time.Location) {
+	e.Datetime = model.InLocation(e.Datetime, loc)
+}
+
+// EXPRequest - builds a EXP request to queue on a batch.Batch
+func EXPRequest(symbol string, interval model.Interval, opts MathTransformOptions) batch.Request[IndicatorResponse[EXPValue, MathTransformIndicator]] {
+	return request[EXPValue, MathTransformIndicator]("exp", symbol, interval, opts)
+}
+
+// CEILValue - the Indicator value for IndicatorResponse specific for CEIL
+type CEILValue struct {
+	Datetime time.Time `json:"datetime"`
+	Ceil     float64   `json:"ceil"`
+}
+
+// UnmarshalJSON - unmarshal's CEILValue to a more consumable type
+func (c *CEILValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Ceil     string `json:"ceil"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	ceil, err := parseFloat(value.Ceil, "ceil")
+	if err != nil {
+		return err
+	}
+
+	c.Datetime = dateTime
+	c.Ceil = ceil
+
+	return nil
+}
+
+func (c *CEILValue) inLocation(loc *time.Location) {
+	c.Datetime = model.InLocation(c.Datetime, loc)
+}
+
+// CEILRequest - builds a CEIL request to queue on a batch.Batch
+func CEILRequest(symbol string, interval model.Interval, opts MathTransformOptions) batch.Request[IndicatorResponse[CEILValue, MathTransformIndicator]] {
+	return request[CEILValue, MathTransformIndicator]("ceil", symbol, interval, opts)
+}
+
+// FLOORValue - the Indicator value for IndicatorResponse specific for FLOOR
+type FLOORValue struct {
+	Datetime time.Time `json:"datetime"`
+	Floor    float64   `json:"floor"`
+}
+
+// UnmarshalJSON - unmarshal's FLOORValue to a more consumable type
+func (f *FLOORValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Floor    string `json:"floor"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	floor, err := parseFloat(value.Floor, "floor")
+	if err != nil {
+		return err
+	}
+
+	f.Datetime = dateTime
+	f.Floor = floor
+
+	return nil
+}
+
+func (f *FLOORValue) inLocation(loc *time.Location) {
+	f.Datetime = model.InLocation(f.Datetime, loc)
+}
+
+// FLOORRequest - builds a FLOOR request to queue on a batch.Batch
+func FLOORRequest(symbol string, interval model.Interval, opts MathTransformOptions) batch.Request[IndicatorResponse[FLOORValue, MathTransformIndicator]] {
+	return request[FLOORValue, MathTransformIndicator]("floor", symbol, interval, opts)
+}
+
+// ADDValue - the Indicator value for IndicatorResponse specific for ADD
+type ADDValue struct {
+	Datetime time.Time `json:"datetime"`
+	Add      float64   `json:"add"`
+}
+
+// UnmarshalJSON - unmarshal's ADDValue to a more consumable type
+func (a *ADDValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Add      string `json:"add"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	add, err := parseFloat(value.Add, "add")
+	if err != nil {
+		return err
+	}
+
+	a.Datetime = dateTime
+	a.Add = add
+
+	return nil
+}
+
+func (a *ADDValue) inLocation(loc *time.Location) {
+	a.Datetime = model.InLocation(a.Datetime, loc)
+}
+
+// ADDRequest - builds a ADD request to queue on a batch.Batch
+func ADDRequest(symbol string, interval model.Interval, opts MathOperatorOptions) batch.Request[IndicatorResponse[ADDValue, MathOperatorIndicator]] {
+	return request[ADDValue, MathOperatorIndicator]("add", symbol, interval, opts)
+}
+
+// SUBValue - the Indicator value for IndicatorResponse specific for SUB
+type SUBValue struct {
+	Datetime time.Time `json:"datetime"`
+	Sub      float64   `json:"sub"`
+}
+
+// UnmarshalJSON - unmarshal's SUBValue to a more consumable type
+func (s *SUBValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Sub      string `json:"sub"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	sub, err := parseFloat(value.Sub, "sub")
+	if err != nil {
+		return err
+	}
+
+	s.Datetime = dateTime
+	s.Sub = sub
+
+	return nil
+}
+
+func (s *SUBValue) inLocation(loc *time.Location) {
+	s.Datetime = model.InLocation(s.Datetime, loc)
+}
+
+// SUBRequest - builds a SUB request to queue on a batch.Batch
+func SUBRequest(symbol string, interval model.Interval, opts MathOperatorOptions) batch.Request[IndicatorResponse[SUBValue, MathOperatorIndicator]] {
+	return request[SUBValue, MathOperatorIndicator]("sub", symbol, interval, opts)
+}
+
+// MULTValue - the Indicator value for IndicatorResponse specific for MULT
+type MULTValue struct {
+	Datetime time.Time `json:"datetime"`
+	Mult     float64   `json:"mult"`
+}
+
+// UnmarshalJSON - unmarshal's MULTValue to a more consumable type
+func (m *MULTValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Mult     string `json:"mult"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	mult, err := parseFloat(value.Mult, "mult")
+	if err != nil {
+		return err
+	}
+
+	m.Datetime = dateTime
+	m.Mult = mult
+
+	return nil
+}
+
+func (m *MULTValue) inLocation(loc *time.Location) {
+	m.Datetime = model.InLocation(m.Datetime, loc)
+}
+
+// MULTRequest - builds a MULT request to queue on a batch.Batch
+func MULTRequest(symbol string, interval model.Interval, opts MathOperatorOptions) batch.Request[IndicatorResponse[MULTValue, MathOperatorIndicator]] {
+	return request[MULTValue, MathOperatorIndicator]("mult", symbol, interval, opts)
+}
+
+// DIVValue - the Indicator value for IndicatorResponse specific for DIV
+type DIVValue struct {
+	Datetime time.Time `json:"datetime"`
+	Div      float64   `json:"div"`
+}
+
+// UnmarshalJSON - unmarshal's DIVValue to a more consumable type
+func (d *DIVValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Div      string `json:"div"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	div, err := parseFloat(value.Div, "div")
+	if err != nil {
+		return err
+	}
+
+	d.Datetime = dateTime
+	d.Div = div
+
+	return nil
+}
+
+func (d *DIVValue) inLocation(loc *time.Location) {
+	d.Datetime = model.InLocation(d.Datetime, loc)
+}
+
+// DIVRequest - builds a DIV request to queue on a batch.Batch
+func DIVRequest(symbol string, interval model.Interval, opts MathOperatorOptions) batch.Request[IndicatorResponse[DIVValue, MathOperatorIndicator]] {
+	return request[DIVValue, MathOperatorIndicator]("div", symbol, interval, opts)
+}
+
+// SUMValue - the Indicator value for IndicatorResponse specific for SUM
+type SUMValue struct {
+	Datetime time.Time `json:"datetime"`
+	Sum      float64   `json:"sum"`
+}
+
+// UnmarshalJSON - unmarshal's SUMValue to a more consumable type
+func (s *SUMValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Sum      string `json:"sum"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	sum, err := parseFloat(value.Sum, "sum")
+	if err != nil {
+		return err
+	}
+
+	s.Datetime = dateTime
+	s.Sum = sum
+
+	return nil
+}
+
+func (s *SUMValue) inLocation(loc *time.Location) {
+	s.Datetime = model.InLocation(s.Datetime, loc)
+}
+
+// SUMRequest - builds a SUM request to queue on a batch.Batch
+func SUMRequest(symbol string, interval model.Interval, opts StatisticOptions) batch.Request[IndicatorResponse[SUMValue, StatisticIndicator]] {
+	return request[SUMValue, StatisticIndicator]("sum", symbol, interval, opts)
+}
+
+// MAXValue - the Indicator value for IndicatorResponse specific for MAX
+type MAXValue struct {
+	Datetime time.Time `json:"datetime"`
+	Max      float64   `json:"max"`
+}
+
+// UnmarshalJSON - unmarshal's MAXValue to a more consumable type
+func (m *MAXValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Max      string `json:"max"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	max, err := parseFloat(value.Max, "max")
+	if err != nil {
+		return err
+	}
+
+	m.Datetime = dateTime
+	m.Max = max
+
+	return nil
+}
+
+func (m *MAXValue) inLocation(loc *time.Location) {
+	m.Datetime = model.InLocation(m.Datetime, loc)
+}
+
+// MAXRequest - builds a MAX request to queue on a batch.Batch
+func MAXRequest(symbol string, interval model.Interval, opts StatisticOptions) batch.Request[IndicatorResponse[MAXValue, StatisticIndicator]] {
+	return request[MAXValue, StatisticIndicator]("max", symbol, interval, opts)
+}
+
+// MINValue - the Indicator value for IndicatorResponse specific for MIN
+type MINValue struct {
+	Datetime time.Time `json:"datetime"`
+	Min      float64   `json:"min"`
+}
+
+// UnmarshalJSON - unmarshal's MINValue to a more consumable type
+func (m *MINValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Min      string `json:"min"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	min, err := parseFloat(value.Min, "min")
+	if err != nil {
+		return err
+	}
+
+	m.Datetime = dateTime
+	m.Min = min
+
+	return nil
+}
+
+func (m *MINValue) inLocation(loc *time.Location) {
+	m.Datetime = model.InLocation(m.Datetime, loc)
+}
+
+// MINRequest - builds a MIN request to queue on a batch.Batch
+func MINRequest(symbol string, interval model.Interval, opts StatisticOptions) batch.Request[IndicatorResponse[MINValue, StatisticIndicator]] {
+	return request[MINValue, StatisticIndicator]("min", symbol, interval, opts)
+}
+
+// AVGValue - the Indicator value for IndicatorResponse specific for AVG
+type AVGValue struct {
+	Datetime time.Time `json:"datetime"`
+	Avg      float64   `json:"avg"`
+}
+
+// UnmarshalJSON - unmarshal's AVGValue to a more consumable type
+func (a *AVGValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Avg      string `json:"avg"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	avg, err := parseFloat(value.Avg, "avg")
+	if err != nil {
+		return err
+	}
+
+	a.Datetime = dateTime
+	a.Avg = avg
+
+	return nil
+}
+
+func (a *AVGValue) inLocation(loc *time.Location) {
+	a.Datetime = model.InLocation(a.Datetime, loc)
+}
+
+// AVGRequest - builds a AVG request to queue on a batch.Batch
+func AVGRequest(symbol string, interval model.Interval, opts StatisticOptions) batch.Request[IndicatorResponse[AVGValue, StatisticIndicator]] {
+	return request[AVGValue, StatisticIndicator]("avg", symbol, interval, opts)
+}
+
+// STDDEVValue - the Indicator value for IndicatorResponse specific for STDDEV
+type STDDEVValue struct {
+	Datetime time.Time `json:"datetime"`
+	Stddev   float64   `json:"stddev"`
+}
+
+// UnmarshalJSON - unmarshal's STDDEVValue to a more consumable type
+func (s *STDDEVValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Stddev   string `json:"stddev"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	stddev, err := parseFloat(value.Stddev, "stddev")
+	if err != nil {
+		return err
+	}
+
+	s.Datetime = dateTime
+	s.Stddev = stddev
+
+	return nil
+}
+
+func (s *STDDEVValue) inLocation(loc *time.Location) {
+	s.Datetime = model.InLocation(s.Datetime, loc)
+}
+
+// STDDEVRequest - builds a STDDEV request to queue on a batch.Batch
+func STDDEVRequest(symbol string, interval model.Interval, opts StatisticOptions) batch.Request[IndicatorResponse[STDDEVValue, StatisticIndicator]] {
+	return request[STDDEVValue, StatisticIndicator]("stddev", symbol, interval, opts)
+}
+
+// VARValue - the Indicator value for IndicatorResponse specific for VAR
+type VARValue struct {
+	Datetime time.Time `json:"datetime"`
+	Var      float64   `json:"var"`
+}
+
+// UnmarshalJSON - unmarshal's VARValue to a more consumable type
+func (va *VARValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Var      string `json:"var"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	varValue, err := parseFloat(value.Var, "var")
+	if err != nil {
+		return err
+	}
+
+	va.Datetime = dateTime
+	va.Var = varValue
+
+	return nil
+}
+
+func (va *VARValue) inLocation(loc *time.Location) {
+	va.Datetime = model.InLocation(va.Datetime, loc)
+}
+
+// VARRequest - builds a VAR request to queue on a batch.Batch
+func VARRequest(symbol string, interval model.Interval, opts StatisticOptions) batch.Request[IndicatorResponse[VARValue, StatisticIndicator]] {
+	return request[VARValue, StatisticIndicator]("var", symbol, interval, opts)
+}
+
+// BETAValue - the Indicator value for IndicatorResponse specific for BETA
+type BETAValue struct {
+	Datetime time.Time `json:"datetime"`
+	Beta     float64   `json:"beta"`
+}
+
+// UnmarshalJSON - unmarshal's BETAValue to a more consumable type
+func (b *BETAValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Beta     string `json:"beta"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	beta, err := parseFloat(value.Beta, "beta")
+	if err != nil {
+		return err
+	}
+
+	b.Datetime = dateTime
+	b.Beta = beta
+
+	return nil
+}
+
+func (b *BETAValue) inLocation(loc *time.Location) {
+	b.Datetime = model.InLocation(b.Datetime, loc)
+}
+
+// BETARequest - builds a BETA request to queue on a batch.Batch
+func BETARequest(symbol string, interval model.Interval, opts CorrelationOptions) batch.Request[IndicatorResponse[BETAValue, CorrelationIndicator]] {
+	return request[BETAValue, CorrelationIndicator]("beta", symbol, interval, opts)
+}
+
+// CORRELValue - the Indicator value for IndicatorResponse specific for CORREL
+type CORRELValue struct {
+	Datetime time.Time `json:"datetime"`
+	Correl   float64   `json:"correl"`
+}
+
+// UnmarshalJSON - unmarshal's CORRELValue to a more consumable type
+func (c *CORRELValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Correl   string `json:"correl"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	correl, err := parseFloat(value.Correl, "correl")
+	if err != nil {
+		return err
+	}
+
+	c.Datetime = dateTime
+	c.Correl = correl
+
+	return nil
+}
+
+func (c *CORRELValue) inLocation(loc *time.Location) {
+	c.Datetime = model.InLocation(c.Datetime, loc)
+}
+
+// CORRELRequest - builds a CORREL request to queue on a batch.Batch
+func CORRELRequest(symbol string, interval model.Interval, opts CorrelationOptions) batch.Request[IndicatorResponse[CORRELValue, CorrelationIndicator]] {
+	return request[CORRELValue, CorrelationIndicator]("correl", symbol, interval, opts)
+}
+
+// LN - gets the Natural Logarithm to the base of constant e: https://twelvedata.com/docs#ln
+func (c *client) LN(symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[LNValue, MathTransformIndicator], error) {
+	return c.LNWithContext(context.Background(), symbol, interval, opts)
+}
+
+// LNWithContext - same as LN, but bound to ctx
+func (c *client) LNWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[LNValue, MathTransformIndicator], error) {
+	return indicator[LNValue, MathTransformIndicator](ctx, c, "ln", symbol, interval, opts)
+}
+
+// LOG10 - gets the Logarithm to base 10: https://twelvedata.com/docs#log10
+func (c *client) LOG10(symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[LOG10Value, MathTransformIndicator], error) {
+	return c.LOG10WithContext(context.Background(), symbol, interval, opts)
+}
+
+// LOG10WithContext - same as LOG10, but bound to ctx
+func (c *client) LOG10WithContext(ctx context.Context, symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[LOG10Value, MathTransformIndicator], error) {
+	return indicator[LOG10Value, MathTransformIndicator](ctx, c, "log10", symbol, interval, opts)
+}
+
+// SQRT - gets the Square Root: https://twelvedata.com/docs#sqrt
+func (c *client) SQRT(symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[SQRTValue, MathTransformIndicator], error) {
+	return c.SQRTWithContext(context.Background(), symbol, interval, opts)
+}
+
+// SQRTWithContext - same as SQRT, but bound to ctx
+func (c *client) SQRTWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[SQRTValue, MathTransformIndicator], error) {
+	return indicator[SQRTValue, MathTransformIndicator](ctx, c, "sqrt", symbol, interval, opts)
+}
+
+// EXP - gets the Exponential: https://twelvedata.com/docs#exp
+func (c *client) EXP(symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[EXPValue, MathTransformIndicator], error) {
+	return c.EXPWithContext(context.Background(), symbol, interval, opts)
+}
+
+// EXPWithContext - same as EXP, but bound to ctx
+func (c *client) EXPWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[EXPValue, MathTransformIndicator], error) {
+	return indicator[EXPValue, MathTransformIndicator](ctx, c, "exp", symbol, interval, opts)
+}
+
+// CEIL - gets the Vector Ceiling: https://twelvedata.com/docs#ceil
+func (c *client) CEIL(symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[CEILValue, MathTransformIndicator], error) {
+	return c.CEILWithContext(context.Background(), symbol, interval, opts)
+}
+
+// CEILWithContext - same as CEIL, but bound to ctx
+func (c *client) CEILWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[CEILValue, MathTransformIndicator], error) {
+	return indicator[CEILValue, MathTransformIndicator](ctx, c, "ceil", symbol, interval, opts)
+}
+
+// FLOOR - gets the Vector Floor: https://twelvedata.com/docs#floor
+func (c *client) FLOOR(symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[FLOORValue, MathTransformIndicator], error) {
+	return c.FLOORWithContext(context.Background(), symbol, interval, opts)
+}
+
+// FLOORWithContext - same as FLOOR, but bound to ctx
+func (c *client) FLOORWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathTransformOptions) (IndicatorResponse[FLOORValue, MathTransformIndicator], error) {
+	return indicator[FLOORValue, MathTransformIndicator](ctx, c, "floor", symbol, interval, opts)
+}
+
+// ADD - gets the Arithmetic Addition: https://twelvedata.com/docs#add
+func (c *client) ADD(symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[ADDValue, MathOperatorIndicator], error) {
+	return c.ADDWithContext(context.Background(), symbol, interval, opts)
+}
+
+// ADDWithContext - same as ADD, but bound to ctx
+func (c *client) ADDWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[ADDValue, MathOperatorIndicator], error) {
+	return indicator[ADDValue, MathOperatorIndicator](ctx, c, "add", symbol, interval, opts)
+}
+
+// SUB - gets the Arithmetic Subtraction: https://twelvedata.com/docs#sub
+func (c *client) SUB(symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[SUBValue, MathOperatorIndicator], error) {
+	return c.SUBWithContext(context.Background(), symbol, interval, opts)
+}
+
+// SUBWithContext - same as SUB, but bound to ctx
+func (c *client) SUBWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[SUBValue, MathOperatorIndicator], error) {
+	return indicator[SUBValue, MathOperatorIndicator](ctx, c, "sub", symbol, interval, opts)
+}
+
+// MULT - gets the Arithmetic Multiply: https://twelvedata.com/docs#mult
+func (c *client) MULT(symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[MULTValue, MathOperatorIndicator], error) {
+	return c.MULTWithContext(context.Background(), symbol, interval, opts)
+}
+
+// MULTWithContext - same as MULT, but bound to ctx
+func (c *client) MULTWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[MULTValue, MathOperatorIndicator], error) {
+	return indicator[MULTValue, MathOperatorIndicator](ctx, c, "mult", symbol, interval, opts)
+}
+
+// DIV - gets the Arithmetic Division: https://twelvedata.com/docs#div
+func (c *client) DIV(symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[DIVValue, MathOperatorIndicator], error) {
+	return c.DIVWithContext(context.Background(), symbol, interval, opts)
+}
+
+// DIVWithContext - same as DIV, but bound to ctx
+func (c *client) DIVWithContext(ctx context.Context, symbol string, interval model.Interval, opts MathOperatorOptions) (IndicatorResponse[DIVValue, MathOperatorIndicator], error) {
+	return indicator[DIVValue, MathOperatorIndicator](ctx, c, "div", symbol, interval, opts)
+}
+
+// SUM - gets the Summation: https://twelvedata.com/docs#sum
+func (c *client) SUM(symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[SUMValue, StatisticIndicator], error) {
+	return c.SUMWithContext(context.Background(), symbol, interval, opts)
+}
+
+// SUMWithContext - same as SUM, but bound to ctx
+func (c *client) SUMWithContext(ctx context.Context, symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[SUMValue, StatisticIndicator], error) {
+	return indicator[SUMValue, StatisticIndicator](ctx, c, "sum", symbol, interval, opts)
+}
+
+// MAX - gets the Highest value over period: https://twelvedata.com/docs#max
+func (c *client) MAX(symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[MAXValue, StatisticIndicator], error) {
+	return c.MAXWithContext(context.Background(), symbol, interval, opts)
+}
+
+// MAXWithContext - same as MAX, but bound to ctx
+func (c *client) MAXWithContext(ctx context.Context, symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[MAXValue, StatisticIndicator], error) {
+	return indicator[MAXValue, StatisticIndicator](ctx, c, "max", symbol, interval, opts)
+}
+
+// MIN - gets the Lowest value over period: https://twelvedata.com/docs#min
+func (c *client) MIN(symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[MINValue, StatisticIndicator], error) {
+	return c.MINWithContext(context.Background(), symbol, interval, opts)
+}
+
+// MINWithContext - same as MIN, but bound to ctx
+func (c *client) MINWithContext(ctx context.Context, symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[MINValue, StatisticIndicator], error) {
+	return indicator[MINValue, StatisticIndicator](ctx, c, "min", symbol, interval, opts)
+}
+
+// AVG - gets the Average: https://twelvedata.com/docs#avg
+func (c *client) AVG(symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[AVGValue, StatisticIndicator], error) {
+	return c.AVGWithContext(context.Background(), symbol, interval, opts)
+}
+
+// AVGWithContext - same as AVG, but bound to ctx
+func (c *client) AVGWithContext(ctx context.Context, symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[AVGValue, StatisticIndicator], error) {
+	return indicator[AVGValue, StatisticIndicator](ctx, c, "avg", symbol, interval, opts)
+}
+
+// STDDEV - gets the Standard Deviation: https://twelvedata.com/docs#stddev
+func (c *client) STDDEV(symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[STDDEVValue, StatisticIndicator], error) {
+	return c.STDDEVWithContext(context.Background(), symbol, interval, opts)
+}
+
+// STDDEVWithContext - same as STDDEV, but bound to ctx
+func (c *client) STDDEVWithContext(ctx context.Context, symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[STDDEVValue, StatisticIndicator], error) {
+	return indicator[STDDEVValue, StatisticIndicator](ctx, c, "stddev", symbol, interval, opts)
+}
+
+// VAR - gets the Variance: https://twelvedata.com/docs#var
+func (c *client) VAR(symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[VARValue, StatisticIndicator], error) {
+	return c.VARWithContext(context.Background(), symbol, interval, opts)
+}
+
+// VARWithContext - same as VAR, but bound to ctx
+func (c *client) VARWithContext(ctx context.Context, symbol string, interval model.Interval, opts StatisticOptions) (IndicatorResponse[VARValue, StatisticIndicator], error) {
+	return indicator[VARValue, StatisticIndicator](ctx, c, "var", symbol, interval, opts)
+}
+
+// BETA - gets the Beta of opts.SeriesType1 against opts.SeriesType2 of the one symbol: https://twelvedata.com/docs#beta.
+// The endpoint cannot compare two symbols, for the beta of a symbol against a benchmark use local.BETA
+func (c *client) BETA(symbol string, interval model.Interval, opts CorrelationOptions) (IndicatorResponse[BETAValue, CorrelationIndicator], error) {
+	return c.BETAWithContext(context.Background(), symbol, interval, opts)
+}
+
+// BETAWithContext - same as BETA, but bound to ctx
+func (c *client) BETAWithContext(ctx context.Context, symbol string, interval model.Interval, opts CorrelationOptions) (IndicatorResponse[BETAValue, CorrelationIndicator], error) {
+	return indicator[BETAValue, CorrelationIndicator](ctx, c, "beta", symbol, interval, opts)
+}
+
+// CORREL - gets the Pearson's Correlation Coefficient of opts.SeriesType1 and opts.SeriesType2 of the one symbol:
+// https://twelvedata.com/docs#correl. The endpoint cannot compare two symbols, to correlate two symbols use local.CORREL
+func (c *client) CORREL(symbol string, interval model.Interval, opts CorrelationOptions) (IndicatorResponse[CORRELValue, CorrelationIndicator], error) {
+	return c.CORRELWithContext(context.Background(), symbol, interval, opts)
+}
+
+// CORRELWithContext - same as CORREL, but bound to ctx
+func (c *client) CORRELWithContext(ctx context.Context, symbol string, interval model.Interval, opts CorrelationOptions) (IndicatorResponse[CORRELValue, CorrelationIndicator], error) {
+	return indicator[CORRELValue, CorrelationIndicator](ctx, c, "correl", symbol, interval, opts)
+}
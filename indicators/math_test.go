@@ -0,0 +1,1714 @@
+package indicators
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	addBody    = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"ADD - Arithmetic Addition","series_type_1":"open","series_type_2":"close"}},"values":[{"datetime":"2023-08-24","add":"357.04000"}],"status":"ok"}`)
+	betaBody   = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"BETA - Beta","series_type_1":"close","series_type_2":"close","time_period":9}},"values":[{"datetime":"2023-08-24","beta":"1.21043"}],"status":"ok"}`)
+	lnBody     = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"LN - Natural Logarithm to the base of constant e","series_type":"close"}},"values":[{"datetime":"2023-08-24","ln":"5.17264"},{"datetime":"2023-08-23","ln":"5.19916"},{"datetime":"2023-08-22","ln":"5.17745"}],"status":"ok"}`)
+	log10Body  = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"LOG10 - Logarithm to base 10","series_type":"close"}},"values":[{"datetime":"2023-08-24","log10":"2.24645"},{"datetime":"2023-08-23","log10":"2.25797"},{"datetime":"2023-08-22","log10":"2.24854"}],"status":"ok"}`)
+	sqrtBody   = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"SQRT - Square Root","series_type":"close"}},"values":[{"datetime":"2023-08-24","sqrt":"13.28081"},{"datetime":"2023-08-23","sqrt":"13.45808"},{"datetime":"2023-08-22","sqrt":"13.31278"}],"status":"ok"}`)
+	expBody    = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"EXP - Exponential","series_type":"close"}},"values":[{"datetime":"2023-08-24","exp":"4.48169"},{"datetime":"2023-08-23","exp":"3.49034"},{"datetime":"2023-08-22","exp":"2.71828"}],"status":"ok"}`)
+	ceilBody   = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"CEIL - Vector Ceil","series_type":"close"}},"values":[{"datetime":"2023-08-24","ceil":"177.00000"},{"datetime":"2023-08-23","ceil":"182.00000"},{"datetime":"2023-08-22","ceil":"178.00000"}],"status":"ok"}`)
+	floorBody  = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"FLOOR - Vector Floor","series_type":"close"}},"values":[{"datetime":"2023-08-24","floor":"176.00000"},{"datetime":"2023-08-23","floor":"181.00000"},{"datetime":"2023-08-22","floor":"177.00000"}],"status":"ok"}`)
+	subBody    = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"SUB - Arithmetic Subtraction","series_type_1":"open","series_type_2":"close"}},"values":[{"datetime":"2023-08-24","sub":"4.29000"},{"datetime":"2023-08-23","sub":"-2.60000"},{"datetime":"2023-08-22","sub":"-0.17000"}],"status":"ok"}`)
+	multBody   = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"MULT - Arithmetic Multiply","series_type_1":"open","series_type_2":"close"}},"values":[{"datetime":"2023-08-24","mult":"31866.57460"},{"datetime":"2023-08-23","mult":"32333.54240"},{"datetime":"2023-08-22","mult":"31380.34380"}],"status":"ok"}`)
+	divBody    = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"DIV - Arithmetic Division","series_type_1":"open","series_type_2":"close"}},"values":[{"datetime":"2023-08-24","div":"1.02432"},{"datetime":"2023-08-23","div":"0.98564"},{"datetime":"2023-08-22","div":"0.99904"}],"status":"ok"}`)
+	sumBody    = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"SUM - Summation","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","sum":"1610.06000"},{"datetime":"2023-08-23","sum":"1613.67000"},{"datetime":"2023-08-22","sum":"1606.62000"}],"status":"ok"}`)
+	maxBody    = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"MAX - Highest value over period","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","max":"181.12000"},{"datetime":"2023-08-23","max":"181.12000"},{"datetime":"2023-08-22","max":"180.19000"}],"status":"ok"}`)
+	minBody    = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"MIN - Lowest value over period","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","min":"174.49000"},{"datetime":"2023-08-23","min":"174.49000"},{"datetime":"2023-08-22","min":"174.49000"}],"status":"ok"}`)
+	avgBody    = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"AVG - Average","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","avg":"178.89556"},{"datetime":"2023-08-23","avg":"179.29667"},{"datetime":"2023-08-22","avg":"178.51333"}],"status":"ok"}`)
+	stddevBody = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"STDDEV - Standard Deviation","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","stddev":"2.00915"},{"datetime":"2023-08-23","stddev":"1.98233"},{"datetime":"2023-08-22","stddev":"1.87522"}],"status":"ok"}`)
+	varBody    = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"VAR - Variance","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","var":"4.03668"},{"datetime":"2023-08-23","var":"3.92963"},{"datetime":"2023-08-22","var":"3.51645"}],"status":"ok"}`)
+	correlBody = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"CORREL - Pearson's Correlation Coefficient (r)","series_type_1":"high","series_type_2":"low","time_period":9}},"values":[{"datetime":"2023-08-24","correl":"0.87214"},{"datetime":"2023-08-23","correl":"0.88927"},{"datetime":"2023-08-22","correl":"0.91342"}],"status":"ok"}`)
+)
+
+func TestIntegrationCORREL(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	_, err := client.CORREL("AAPL", model.OneDay, CorrelationOptions{})
+	if err != nil {
+		t.Log("Failed to make CORREL request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitLN(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MathTransformOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MathTransformIndicator
+		values    int
+		first     LNValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","ln":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value ln into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return lnBody, nil
+				},
+				opts: MathTransformOptions{IndicatorOptions{SeriesType: SeriesTypeHigh}},
+			},
+			want{
+				params:    url.Values{"series_type": {"high"}},
+				indicator: MathTransformIndicator{Name: "LN - Natural Logarithm to the base of constant e", SeriesType: "close"},
+				values:    3,
+				first:     LNValue{Datetime: inNewYork(2023, time.August, 24), Ln: 5.17264},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.LN("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/ln", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitLOG10(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MathTransformOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MathTransformIndicator
+		values    int
+		first     LOG10Value
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","log10":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value log10 into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return log10Body, nil
+				},
+			},
+			want{
+				indicator: MathTransformIndicator{Name: "LOG10 - Logarithm to base 10", SeriesType: "close"},
+				values:    3,
+				first:     LOG10Value{Datetime: inNewYork(2023, time.August, 24), Log10: 2.24645},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.LOG10("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/log10", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitSQRT(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MathTransformOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MathTransformIndicator
+		values    int
+		first     SQRTValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","sqrt":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value sqrt into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return sqrtBody, nil
+				},
+			},
+			want{
+				indicator: MathTransformIndicator{Name: "SQRT - Square Root", SeriesType: "close"},
+				values:    3,
+				first:     SQRTValue{Datetime: inNewYork(2023, time.August, 24), Sqrt: 13.28081},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.SQRT("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/sqrt", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitEXP(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MathTransformOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MathTransformIndicator
+		values    int
+		first     EXPValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","exp":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value exp into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return expBody, nil
+				},
+			},
+			want{
+				indicator: MathTransformIndicator{Name: "EXP - Exponential", SeriesType: "close"},
+				values:    3,
+				first:     EXPValue{Datetime: inNewYork(2023, time.August, 24), Exp: 4.48169},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.EXP("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/exp", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitCEIL(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MathTransformOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MathTransformIndicator
+		values    int
+		first     CEILValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","ceil":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value ceil into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return ceilBody, nil
+				},
+			},
+			want{
+				indicator: MathTransformIndicator{Name: "CEIL - Vector Ceil", SeriesType: "close"},
+				values:    3,
+				first:     CEILValue{Datetime: inNewYork(2023, time.August, 24), Ceil: 177.0},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.CEIL("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/ceil", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitFLOOR(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MathTransformOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MathTransformIndicator
+		values    int
+		first     FLOORValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","floor":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value floor into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return floorBody, nil
+				},
+			},
+			want{
+				indicator: MathTransformIndicator{Name: "FLOOR - Vector Floor", SeriesType: "close"},
+				values:    3,
+				first:     FLOORValue{Datetime: inNewYork(2023, time.August, 24), Floor: 176.0},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.FLOOR("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/floor", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitADD(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MathOperatorOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MathOperatorIndicator
+		values    int
+		first     ADDValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","add":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value add into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return addBody, nil
+				},
+				opts: MathOperatorOptions{SeriesType1: SeriesTypeOpen, SeriesType2: SeriesTypeClose},
+			},
+			want{
+				params:    url.Values{"series_type_1": {"open"}, "series_type_2": {"close"}},
+				indicator: MathOperatorIndicator{Name: "ADD - Arithmetic Addition", SeriesType1: "open", SeriesType2: "close"},
+				values:    1,
+				first:     ADDValue{Datetime: inNewYork(2023, time.August, 24), Add: 357.04},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.ADD("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/add", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitSUB(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MathOperatorOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MathOperatorIndicator
+		values    int
+		first     SUBValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","sub":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value sub into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return subBody, nil
+				},
+			},
+			want{
+				indicator: MathOperatorIndicator{Name: "SUB - Arithmetic Subtraction", SeriesType1: "open", SeriesType2: "close"},
+				values:    3,
+				first:     SUBValue{Datetime: inNewYork(2023, time.August, 24), Sub: 4.29},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.SUB("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/sub", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitMULT(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MathOperatorOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MathOperatorIndicator
+		values    int
+		first     MULTValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","mult":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value mult into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return multBody, nil
+				},
+			},
+			want{
+				indicator: MathOperatorIndicator{Name: "MULT - Arithmetic Multiply", SeriesType1: "open", SeriesType2: "close"},
+				values:    3,
+				first:     MULTValue{Datetime: inNewYork(2023, time.August, 24), Mult: 31866.5746},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.MULT("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/mult", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitDIV(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MathOperatorOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MathOperatorIndicator
+		values    int
+		first     DIVValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","div":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value div into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return divBody, nil
+				},
+			},
+			want{
+				indicator: MathOperatorIndicator{Name: "DIV - Arithmetic Division", SeriesType1: "open", SeriesType2: "close"},
+				values:    3,
+				first:     DIVValue{Datetime: inNewYork(2023, time.August, 24), Div: 1.02432},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.DIV("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/div", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitSUM(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  StatisticOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator StatisticIndicator
+		values    int
+		first     SUMValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","sum":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value sum into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return sumBody, nil
+				},
+			},
+			want{
+				indicator: StatisticIndicator{Name: "SUM - Summation", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     SUMValue{Datetime: inNewYork(2023, time.August, 24), Sum: 1610.06},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.SUM("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/sum", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitMAX(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  StatisticOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator StatisticIndicator
+		values    int
+		first     MAXValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","max":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value max into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return maxBody, nil
+				},
+			},
+			want{
+				indicator: StatisticIndicator{Name: "MAX - Highest value over period", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     MAXValue{Datetime: inNewYork(2023, time.August, 24), Max: 181.12},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.MAX("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/max", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitMIN(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  StatisticOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator StatisticIndicator
+		values    int
+		first     MINValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","min":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value min into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return minBody, nil
+				},
+			},
+			want{
+				indicator: StatisticIndicator{Name: "MIN - Lowest value over period", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     MINValue{Datetime: inNewYork(2023, time.August, 24), Min: 174.49},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.MIN("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/min", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitAVG(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  StatisticOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator StatisticIndicator
+		values    int
+		first     AVGValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","avg":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value avg into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return avgBody, nil
+				},
+			},
+			want{
+				indicator: StatisticIndicator{Name: "AVG - Average", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     AVGValue{Datetime: inNewYork(2023, time.August, 24), Avg: 178.89556},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.AVG("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/avg", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitSTDDEV(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  StatisticOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator StatisticIndicator
+		values    int
+		first     STDDEVValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","stddev":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value stddev into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return stddevBody, nil
+				},
+				opts: StatisticOptions{TimePeriod: 9},
+			},
+			want{
+				params:    url.Values{"time_period": {"9"}},
+				indicator: StatisticIndicator{Name: "STDDEV - Standard Deviation", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     STDDEVValue{Datetime: inNewYork(2023, time.August, 24), Stddev: 2.00915},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.STDDEV("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/stddev", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitVAR(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  StatisticOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator StatisticIndicator
+		values    int
+		first     VARValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","var":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value var into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return varBody, nil
+				},
+			},
+			want{
+				indicator: StatisticIndicator{Name: "VAR - Variance", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     VARValue{Datetime: inNewYork(2023, time.August, 24), Var: 4.03668},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.VAR("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/var", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitBETA(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  CorrelationOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator CorrelationIndicator
+		values    int
+		first     BETAValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","beta":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value beta into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return betaBody, nil
+				},
+				opts: CorrelationOptions{TimePeriod: 9},
+			},
+			want{
+				params:    url.Values{"symbol": {"AAPL"}},
+				indicator: CorrelationIndicator{Name: "BETA - Beta", SeriesType1: "close", SeriesType2: "close", TimePeriod: 9},
+				values:    1,
+				first:     BETAValue{Datetime: inNewYork(2023, time.August, 24), Beta: 1.21043},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.BETA("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/beta", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitCORREL(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  CorrelationOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator CorrelationIndicator
+		values    int
+		first     CORRELValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","correl":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value correl into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return correlBody, nil
+				},
+				opts: CorrelationOptions{SeriesType1: SeriesTypeHigh, SeriesType2: SeriesTypeLow},
+			},
+			want{
+				params:    url.Values{"series_type_1": {"high"}, "series_type_2": {"low"}},
+				indicator: CorrelationIndicator{Name: "CORREL - Pearson's Correlation Coefficient (r)", SeriesType1: "high", SeriesType2: "low", TimePeriod: 9},
+				values:    3,
+				first:     CORRELValue{Datetime: inNewYork(2023, time.August, 24), Correl: 0.87214},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.CORREL("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/correl", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
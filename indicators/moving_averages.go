@@ -0,0 +1,553 @@
+package indicators
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+)
+
+// MovingAverageIndicator - the Indicator value for IndicatorMeta shared by the moving averages with only a time period
+type MovingAverageIndicator struct {
+	Name       string `json:"name"`
+	SeriesType string `json:"series_type"`
+	TimePeriod int    `json:"time_period"`
+}
+
+// T3MAIndicator - the Indicator value for IndicatorMeta specific for T3MA
+type T3MAIndicator struct {
+	Name       string  `json:"name"`
+	SeriesType string  `json:"series_type"`
+	TimePeriod int     `json:"time_period"`
+	VFactor    float64 `json:"v_factor"`
+}
+
+// MAIndicator - the Indicator value for IndicatorMeta specific for MA
+type MAIndicator struct {
+	Name       string `json:"name"`
+	SeriesType string `json:"series_type"`
+	TimePeriod int    `json:"time_period"`
+	MAType     MAType `json:"ma_type"`
+}
+
+// MovingAverageOptions - options for calling the twelvedata moving average endpoints with only a time period,
+// e.g. https://twelvedata.com/docs#sma
+type MovingAverageOptions struct {
+	IndicatorOptions
+	TimePeriod int
+}
+
+func (m MovingAverageOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = m.IndicatorOptions.params(u, urlValues)
+
+	if m.TimePeriod > 0 {
+		urlValues.Add("time_period", strconv.Itoa(m.TimePeriod))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// T3MAOptions - options for calling the twelvedata t3ma endpoint: https://twelvedata.com/docs#t3ma
+type T3MAOptions struct {
+	IndicatorOptions
+	TimePeriod int
+	VFactor    float64
+}
+
+func (t T3MAOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = t.IndicatorOptions.params(u, urlValues)
+
+	if t.TimePeriod > 0 {
+		urlValues.Add("time_period", strconv.Itoa(t.TimePeriod))
+	}
+
+	if t.VFactor > 0 {
+		urlValues.Add("v_factor", strconv.FormatFloat(t.VFactor, 'f', -1, 64))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// MAOptions - options for calling the twelvedata ma endpoint: https://twelvedata.com/docs#ma
+type MAOptions struct {
+	IndicatorOptions
+	TimePeriod int
+	MAType     MAType
+}
+
+func (m MAOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = m.IndicatorOptions.params(u, urlValues)
+
+	if m.TimePeriod > 0 {
+		urlValues.Add("time_period", strconv.Itoa(m.TimePeriod))
+	}
+
+	if m.MAType != "" {
+		urlValues.Add("ma_type", string(m.MAType))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// SMAValue - the Indicator value for IndicatorResponse specific for SMA
+type SMAValue struct {
+	Datetime time.Time `json:"datetime"`
+	Sma      float64   `json:"sma"`
+}
+
+// UnmarshalJSON - unmarshal's SMAValue to a more consumable type
+func (s *SMAValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Sma      string `json:"sma"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	sma, err := parseFloat(value.Sma, "sma")
+	if err != nil {
+		return err
+	}
+
+	s.Datetime = dateTime
+	s.Sma = sma
+
+	return nil
+}
+
+func (s *SMAValue) inLocation(loc *time.Location) {
+	s.Datetime = model.InLocation(s.Datetime, loc)
+}
+
+// SMARequest - builds a SMA request to queue on a batch.Batch
+func SMARequest(symbol string, interval model.Interval, opts MovingAverageOptions) batch.Request[IndicatorResponse[SMAValue, MovingAverageIndicator]] {
+	return request[SMAValue, MovingAverageIndicator]("sma", symbol, interval, opts)
+}
+
+// WMAValue - the Indicator value for IndicatorResponse specific for WMA
+type WMAValue struct {
+	Datetime time.Time `json:"datetime"`
+	Wma      float64   `json:"wma"`
+}
+
+// UnmarshalJSON - unmarshal's WMAValue to a more consumable type
+func (w *WMAValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Wma      string `json:"wma"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	wma, err := parseFloat(value.Wma, "wma")
+	if err != nil {
+		return err
+	}
+
+	w.Datetime = dateTime
+	w.Wma = wma
+
+	return nil
+}
+
+func (w *WMAValue) inLocation(loc *time.Location) {
+	w.Datetime = model.InLocation(w.Datetime, loc)
+}
+
+// WMARequest - builds a WMA request to queue on a batch.Batch
+func WMARequest(symbol string, interval model.Interval, opts MovingAverageOptions) batch.Request[IndicatorResponse[WMAValue, MovingAverageIndicator]] {
+	return request[WMAValue, MovingAverageIndicator]("wma", symbol, interval, opts)
+}
+
+// DEMAValue - the Indicator value for IndicatorResponse specific for DEMA
+type DEMAValue struct {
+	Datetime time.Time `json:"datetime"`
+	Dema     float64   `json:"dema"`
+}
+
+// UnmarshalJSON - unmarshal's DEMAValue to a more consumable type
+func (d *DEMAValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Dema     string `json:"dema"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	dema, err := parseFloat(value.Dema, "dema")
+	if err != nil {
+		return err
+	}
+
+	d.Datetime = dateTime
+	d.Dema = dema
+
+	return nil
+}
+
+func (d *DEMAValue) inLocation(loc *time.Location) {
+	d.Datetime = model.InLocation(d.Datetime, loc)
+}
+
+// DEMARequest - builds a DEMA request to queue on a batch.Batch
+func DEMARequest(symbol string, interval model.Interval, opts MovingAverageOptions) batch.Request[IndicatorResponse[DEMAValue, MovingAverageIndicator]] {
+	return request[DEMAValue, MovingAverageIndicator]("dema", symbol, interval, opts)
+}
+
+// TEMAValue - the Indicator value for IndicatorResponse specific for TEMA
+type TEMAValue struct {
+	Datetime time.Time `json:"datetime"`
+	Tema     float64   `json:"tema"`
+}
+
+// UnmarshalJSON - unmarshal's TEMAValue to a more consumable type
+func (t *TEMAValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Tema     string `json:"tema"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	tema, err := parseFloat(value.Tema, "tema")
+	if err != nil {
+		return err
+	}
+
+	t.Datetime = dateTime
+	t.Tema = tema
+
+	return nil
+}
+
+func (t *TEMAValue) inLocation(loc *time.Location) {
+	t.Datetime = model.InLocation(t.Datetime, loc)
+}
+
+// TEMARequest - builds a TEMA request to queue on a batch.Batch
+func TEMARequest(symbol string, interval model.Interval, opts MovingAverageOptions) batch.Request[IndicatorResponse[TEMAValue, MovingAverageIndicator]] {
+	return request[TEMAValue, MovingAverageIndicator]("tema", symbol, interval, opts)
+}
+
+// KAMAValue - the Indicator value for IndicatorResponse specific for KAMA
+type KAMAValue struct {
+	Datetime time.Time `json:"datetime"`
+	Kama     float64   `json:"kama"`
+}
+
+// UnmarshalJSON - unmarshal's KAMAValue to a more consumable type
+func (k *KAMAValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Kama     string `json:"kama"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	kama, err := parseFloat(value.Kama, "kama")
+	if err != nil {
+		return err
+	}
+
+	k.Datetime = dateTime
+	k.Kama = kama
+
+	return nil
+}
+
+func (k *KAMAValue) inLocation(loc *time.Location) {
+	k.Datetime = model.InLocation(k.Datetime, loc)
+}
+
+// KAMARequest - builds a KAMA request to queue on a batch.Batch
+func KAMARequest(symbol string, interval model.Interval, opts MovingAverageOptions) batch.Request[IndicatorResponse[KAMAValue, MovingAverageIndicator]] {
+	return request[KAMAValue, MovingAverageIndicator]("kama", symbol, interval, opts)
+}
+
+// T3MAValue - the Indicator value for IndicatorResponse specific for T3MA
+type T3MAValue struct {
+	Datetime time.Time `json:"datetime"`
+	T3ma     float64   `json:"t3ma"`
+}
+
+// UnmarshalJSON - unmarshal's T3MAValue to a more consumable type
+func (t *T3MAValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		T3ma     string `json:"t3ma"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	t3ma, err := parseFloat(value.T3ma, "t3ma")
+	if err != nil {
+		return err
+	}
+
+	t.Datetime = dateTime
+	t.T3ma = t3ma
+
+	return nil
+}
+
+func (t *T3MAValue) inLocation(loc *time.Location) {
+	t.Datetime = model.InLocation(t.Datetime, loc)
+}
+
+// T3MARequest - builds a T3MA request to queue on a batch.Batch
+func T3MARequest(symbol string, interval model.Interval, opts T3MAOptions) batch.Request[IndicatorResponse[T3MAValue, T3MAIndicator]] {
+	return request[T3MAValue, T3MAIndicator]("t3ma", symbol, interval, opts)
+}
+
+// TRIMAValue - the Indicator value for IndicatorResponse specific for TRIMA
+type TRIMAValue struct {
+	Datetime time.Time `json:"datetime"`
+	Trima    float64   `json:"trima"`
+}
+
+// UnmarshalJSON - unmarshal's TRIMAValue to a more consumable type
+func (t *TRIMAValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Trima    string `json:"trima"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	trima, err := parseFloat(value.Trima, "trima")
+	if err != nil {
+		return err
+	}
+
+	t.Datetime = dateTime
+	t.Trima = trima
+
+	return nil
+}
+
+func (t *TRIMAValue) inLocation(loc *time.Location) {
+	t.Datetime = model.InLocation(t.Datetime, loc)
+}
+
+// TRIMARequest - builds a TRIMA request to queue on a batch.Batch
+func TRIMARequest(symbol string, interval model.Interval, opts MovingAverageOptions) batch.Request[IndicatorResponse[TRIMAValue, MovingAverageIndicator]] {
+	return request[TRIMAValue, MovingAverageIndicator]("trima", symbol, interval, opts)
+}
+
+// HMAValue - the Indicator value for IndicatorResponse specific for HMA
+type HMAValue struct {
+	Datetime time.Time `json:"datetime"`
+	Hma      float64   `json:"hma"`
+}
+
+// UnmarshalJSON - unmarshal's HMAValue to a more consumable type
+func (h *HMAValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Hma      string `json:"hma"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	hma, err := parseFloat(value.Hma, "hma")
+	if err != nil {
+		return err
+	}
+
+	h.Datetime = dateTime
+	h.Hma = hma
+
+	return nil
+}
+
+func (h *HMAValue) inLocation(loc *time.Location) {
+	h.Datetime = model.InLocation(h.Datetime, loc)
+}
+
+// HMARequest - builds a HMA request to queue on a batch.Batch
+func HMARequest(symbol string, interval model.Interval, opts MovingAverageOptions) batch.Request[IndicatorResponse[HMAValue, MovingAverageIndicator]] {
+	return request[HMAValue, MovingAverageIndicator]("hma", symbol, interval, opts)
+}
+
+// MAValue - the Indicator value for IndicatorResponse specific for MA
+type MAValue struct {
+	Datetime time.Time `json:"datetime"`
+	Ma       float64   `json:"ma"`
+}
+
+// UnmarshalJSON - unmarshal's MAValue to a more consumable type
+func (m *MAValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Ma       string `json:"ma"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	ma, err := parseFloat(value.Ma, "ma")
+	if err != nil {
+		return err
+	}
+
+	m.Datetime = dateTime
+	m.Ma = ma
+
+	return nil
+}
+
+func (m *MAValue) inLocation(loc *time.Location) {
+	m.Datetime = model.InLocation(m.Datetime, loc)
+}
+
+// MARequest - builds a MA request to queue on a batch.Batch
+func MARequest(symbol string, interval model.Interval, opts MAOptions) batch.Request[IndicatorResponse[MAValue, MAIndicator]] {
+	return request[MAValue, MAIndicator]("ma", symbol, interval, opts)
+}
+
+// SMA - gets the Simple Moving Average: https://twelvedata.com/docs#sma
+func (c *client) SMA(symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[SMAValue, MovingAverageIndicator], error) {
+	return c.SMAWithContext(context.Background(), symbol, interval, opts)
+}
+
+// SMAWithContext - same as SMA, but bound to ctx
+func (c *client) SMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[SMAValue, MovingAverageIndicator], error) {
+	return indicator[SMAValue, MovingAverageIndicator](ctx, c, "sma", symbol, interval, opts)
+}
+
+// WMA - gets the Weighted Moving Average: https://twelvedata.com/docs#wma
+func (c *client) WMA(symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[WMAValue, MovingAverageIndicator], error) {
+	return c.WMAWithContext(context.Background(), symbol, interval, opts)
+}
+
+// WMAWithContext - same as WMA, but bound to ctx
+func (c *client) WMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[WMAValue, MovingAverageIndicator], error) {
+	return indicator[WMAValue, MovingAverageIndicator](ctx, c, "wma", symbol, interval, opts)
+}
+
+// DEMA - gets the Double Exponential Moving Average: https://twelvedata.com/docs#dema
+func (c *client) DEMA(symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[DEMAValue, MovingAverageIndicator], error) {
+	return c.DEMAWithContext(context.Background(), symbol, interval, opts)
+}
+
+// DEMAWithContext - same as DEMA, but bound to ctx
+func (c *client) DEMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[DEMAValue, MovingAverageIndicator], error) {
+	return indicator[DEMAValue, MovingAverageIndicator](ctx, c, "dema", symbol, interval, opts)
+}
+
+// TEMA - gets the Triple Exponential Moving Average: https://twelvedata.com/docs#tema
+func (c *client) TEMA(symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[TEMAValue, MovingAverageIndicator], error) {
+	return c.TEMAWithContext(context.Background(), symbol, interval, opts)
+}
+
+// TEMAWithContext - same as TEMA, but bound to ctx
+func (c *client) TEMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[TEMAValue, MovingAverageIndicator], error) {
+	return indicator[TEMAValue, MovingAverageIndicator](ctx, c, "tema", symbol, interval, opts)
+}
+
+// KAMA - gets the Kaufman's Adaptive Moving Average: https://twelvedata.com/docs#kama
+func (c *client) KAMA(symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[KAMAValue, MovingAverageIndicator], error) {
+	return c.KAMAWithContext(context.Background(), symbol, interval, opts)
+}
+
+// KAMAWithContext - same as KAMA, but bound to ctx
+func (c *client) KAMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[KAMAValue, MovingAverageIndicator], error) {
+	return indicator[KAMAValue, MovingAverageIndicator](ctx, c, "kama", symbol, interval, opts)
+}
+
+// T3MA - gets the Triple Exponential Moving Average (T3): https://twelvedata.com/docs#t3ma
+func (c *client) T3MA(symbol string, interval model.Interval, opts T3MAOptions) (IndicatorResponse[T3MAValue, T3MAIndicator], error) {
+	return c.T3MAWithContext(context.Background(), symbol, interval, opts)
+}
+
+// T3MAWithContext - same as T3MA, but bound to ctx
+func (c *client) T3MAWithContext(ctx context.Context, symbol string, interval model.Interval, opts T3MAOptions) (IndicatorResponse[T3MAValue, T3MAIndicator], error) {
+	return indicator[T3MAValue, T3MAIndicator](ctx, c, "t3ma", symbol, interval, opts)
+}
+
+// TRIMA - gets the Triangular Moving Average: https://twelvedata.com/docs#trima
+func (c *client) TRIMA(symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[TRIMAValue, MovingAverageIndicator], error) {
+	return c.TRIMAWithContext(context.Background(), symbol, interval, opts)
+}
+
+// TRIMAWithContext - same as TRIMA, but bound to ctx
+func (c *client) TRIMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[TRIMAValue, MovingAverageIndicator], error) {
+	return indicator[TRIMAValue, MovingAverageIndicator](ctx, c, "trima", symbol, interval, opts)
+}
+
+// HMA - gets the Hull Moving Average: https://twelvedata.com/docs#hma
+func (c *client) HMA(symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[HMAValue, MovingAverageIndicator], error) {
+	return c.HMAWithContext(context.Background(), symbol, interval, opts)
+}
+
+// HMAWithContext - same as HMA, but bound to ctx
+func (c *client) HMAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MovingAverageOptions) (IndicatorResponse[HMAValue, MovingAverageIndicator], error) {
+	return indicator[HMAValue, MovingAverageIndicator](ctx, c, "hma", symbol, interval, opts)
+}
+
+// MA - gets the Moving Average: https://twelvedata.com/docs#ma
+func (c *client) MA(symbol string, interval model.Interval, opts MAOptions) (IndicatorResponse[MAValue, MAIndicator], error) {
+	return c.MAWithContext(context.Background(), symbol, interval, opts)
+}
+
+// MAWithContext - same as MA, but bound to ctx
+func (c *client) MAWithContext(ctx context.Context, symbol string, interval model.Interval, opts MAOptions) (IndicatorResponse[MAValue, MAIndicator], error) {
+	return indicator[MAValue, MAIndicator](ctx, c, "ma", symbol, interval, opts)
+}
@@ -0,0 +1,873 @@
+package indicators
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	smaBody   = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"SMA - Simple Moving Average","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","sma":"178.45111"},{"datetime":"2023-08-23","sma":"178.66556"}],"status":"ok"}`)
+	t3maBody  = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"T3MA - Triple Exponential Moving Average","series_type":"close","time_period":9,"v_factor":0.7}},"values":[{"datetime":"2023-08-24","t3ma":"177.93452"}],"status":"ok"}`)
+	maBody    = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"MA - Moving Average","series_type":"close","time_period":9,"ma_type":"WMA"}},"values":[{"datetime":"2023-08-24","ma":"178.12004"}],"status":"ok"}`)
+	wmaBody   = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"WMA - Weighted Moving Average","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","wma":"178.32044"},{"datetime":"2023-08-23","wma":"178.92311"},{"datetime":"2023-08-22","wma":"178.37467"}],"status":"ok"}`)
+	demaBody  = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"DEMA - Double Exponential Moving Average","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","dema":"178.21739"},{"datetime":"2023-08-23","dema":"179.39520"},{"datetime":"2023-08-22","dema":"178.29358"}],"status":"ok"}`)
+	temaBody  = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"TEMA - Triple Exponential Moving Average","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","tema":"177.74165"},{"datetime":"2023-08-23","tema":"179.88647"},{"datetime":"2023-08-22","tema":"178.56781"}],"status":"ok"}`)
+	kamaBody  = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"KAMA - Kaufman's Adaptive Moving Average","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","kama":"177.98214"},{"datetime":"2023-08-23","kama":"178.09713"},{"datetime":"2023-08-22","kama":"177.63220"}],"status":"ok"}`)
+	trimaBody = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"TRIMA - Triangular Moving Average","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","trima":"178.44067"},{"datetime":"2023-08-23","trima":"178.31867"},{"datetime":"2023-08-22","trima":"178.22733"}],"status":"ok"}`)
+	hmaBody   = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"HMA - Hull Moving Average","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","hma":"179.21574"},{"datetime":"2023-08-23","hma":"180.03152"},{"datetime":"2023-08-22","hma":"178.67430"}],"status":"ok"}`)
+)
+
+func TestIntegrationSMA(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	_, err := client.SMA("AAPL", model.OneHour, MovingAverageOptions{})
+	if err != nil {
+		t.Log("Failed to make SMA request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitSMA(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MovingAverageOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MovingAverageIndicator
+		values    int
+		first     SMAValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","sma":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value sma into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return smaBody, nil
+				},
+				opts: MovingAverageOptions{TimePeriod: 20},
+			},
+			want{
+				params:    url.Values{"time_period": {"20"}},
+				indicator: MovingAverageIndicator{Name: "SMA - Simple Moving Average", SeriesType: "close", TimePeriod: 9},
+				values:    2,
+				first:     SMAValue{Datetime: inNewYork(2023, time.August, 24), Sma: 178.45111},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.SMA("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/sma", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitWMA(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MovingAverageOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MovingAverageIndicator
+		values    int
+		first     WMAValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","wma":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value wma into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return wmaBody, nil
+				},
+			},
+			want{
+				indicator: MovingAverageIndicator{Name: "WMA - Weighted Moving Average", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     WMAValue{Datetime: inNewYork(2023, time.August, 24), Wma: 178.32044},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.WMA("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/wma", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitDEMA(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MovingAverageOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MovingAverageIndicator
+		values    int
+		first     DEMAValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","dema":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value dema into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return demaBody, nil
+				},
+			},
+			want{
+				indicator: MovingAverageIndicator{Name: "DEMA - Double Exponential Moving Average", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     DEMAValue{Datetime: inNewYork(2023, time.August, 24), Dema: 178.21739},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.DEMA("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/dema", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitTEMA(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MovingAverageOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MovingAverageIndicator
+		values    int
+		first     TEMAValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","tema":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value tema into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return temaBody, nil
+				},
+			},
+			want{
+				indicator: MovingAverageIndicator{Name: "TEMA - Triple Exponential Moving Average", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     TEMAValue{Datetime: inNewYork(2023, time.August, 24), Tema: 177.74165},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.TEMA("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/tema", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitKAMA(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MovingAverageOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MovingAverageIndicator
+		values    int
+		first     KAMAValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","kama":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value kama into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return kamaBody, nil
+				},
+			},
+			want{
+				indicator: MovingAverageIndicator{Name: "KAMA - Kaufman's Adaptive Moving Average", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     KAMAValue{Datetime: inNewYork(2023, time.August, 24), Kama: 177.98214},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.KAMA("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/kama", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitT3MA(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  T3MAOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator T3MAIndicator
+		values    int
+		first     T3MAValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","t3ma":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value t3ma into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return t3maBody, nil
+				},
+				opts: T3MAOptions{VFactor: 0.7},
+			},
+			want{
+				params:    url.Values{"v_factor": {"0.7"}},
+				indicator: T3MAIndicator{Name: "T3MA - Triple Exponential Moving Average", SeriesType: "close", TimePeriod: 9, VFactor: 0.7},
+				values:    1,
+				first:     T3MAValue{Datetime: inNewYork(2023, time.August, 24), T3ma: 177.93452},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.T3MA("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/t3ma", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitTRIMA(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MovingAverageOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MovingAverageIndicator
+		values    int
+		first     TRIMAValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","trima":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value trima into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return trimaBody, nil
+				},
+			},
+			want{
+				indicator: MovingAverageIndicator{Name: "TRIMA - Triangular Moving Average", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     TRIMAValue{Datetime: inNewYork(2023, time.August, 24), Trima: 178.44067},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.TRIMA("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/trima", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitHMA(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MovingAverageOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MovingAverageIndicator
+		values    int
+		first     HMAValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","hma":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value hma into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return hmaBody, nil
+				},
+			},
+			want{
+				indicator: MovingAverageIndicator{Name: "HMA - Hull Moving Average", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     HMAValue{Datetime: inNewYork(2023, time.August, 24), Hma: 179.21574},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.HMA("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/hma", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitMA(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MAOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MAIndicator
+		values    int
+		first     MAValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","ma":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value ma into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return maBody, nil
+				},
+				opts: MAOptions{MAType: MATypeWMA},
+			},
+			want{
+				params:    url.Values{"ma_type": {"WMA"}},
+				indicator: MAIndicator{Name: "MA - Moving Average", SeriesType: "close", TimePeriod: 9, MAType: "WMA"},
+				values:    1,
+				first:     MAValue{Datetime: inNewYork(2023, time.August, 24), Ma: 178.12004},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.MA("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/ma", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
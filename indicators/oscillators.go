@@ -0,0 +1,757 @@
+package indicators
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+)
+
+// OscillatorIndicator - the Indicator value for IndicatorMeta shared by the oscillators with only a time period
+type OscillatorIndicator struct {
+	Name       string `json:"name"`
+	SeriesType string `json:"series_type"`
+	TimePeriod int    `json:"time_period"`
+}
+
+// PriceOscillatorIndicator - the Indicator value for IndicatorMeta shared by PPO and APO
+type PriceOscillatorIndicator struct {
+	Name       string `json:"name"`
+	SeriesType string `json:"series_type"`
+	FastPeriod int    `json:"fast_period"`
+	SlowPeriod int    `json:"slow_period"`
+	MAType     MAType `json:"ma_type"`
+}
+
+// ULTOSCIndicator - the Indicator value for IndicatorMeta specific for ULTOSC
+type ULTOSCIndicator struct {
+	Name        string `json:"name"`
+	TimePeriod1 int    `json:"time_period_1"`
+	TimePeriod2 int    `json:"time_period_2"`
+	TimePeriod3 int    `json:"time_period_3"`
+}
+
+// StochRSIIndicator - the Indicator value for IndicatorMeta specific for StochRSI
+type StochRSIIndicator struct {
+	Name        string `json:"name"`
+	SeriesType  string `json:"series_type"`
+	RSILength   int    `json:"rsi_length"`
+	StochLength int    `json:"stoch_length"`
+	KPeriod     int    `json:"k_period"`
+	DPeriod     int    `json:"d_period"`
+}
+
+// StochFIndicator - the Indicator value for IndicatorMeta specific for StochF
+type StochFIndicator struct {
+	Name        string `json:"name"`
+	FastKPeriod int    `json:"fast_k_period"`
+	FastDPeriod int    `json:"fast_d_period"`
+	FastDMAType MAType `json:"fast_dma_type"`
+}
+
+// OscillatorOptions - options for calling the twelvedata oscillator endpoints with only a time period, e.g.
+// https://twelvedata.com/docs#cci
+type OscillatorOptions struct {
+	IndicatorOptions
+	TimePeriod int
+}
+
+func (o OscillatorOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = o.IndicatorOptions.params(u, urlValues)
+
+	if o.TimePeriod > 0 {
+		urlValues.Add("time_period", strconv.Itoa(o.TimePeriod))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// PriceOscillatorOptions - options for calling the twelvedata ppo and apo endpoints: https://twelvedata.com/docs#ppo
+type PriceOscillatorOptions struct {
+	IndicatorOptions
+	FastPeriod int
+	SlowPeriod int
+	MAType     MAType
+}
+
+func (p PriceOscillatorOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = p.IndicatorOptions.params(u, urlValues)
+
+	if p.FastPeriod > 0 {
+		urlValues.Add("fast_period", strconv.Itoa(p.FastPeriod))
+	}
+
+	if p.SlowPeriod > 0 {
+		urlValues.Add("slow_period", strconv.Itoa(p.SlowPeriod))
+	}
+
+	if p.MAType != "" {
+		urlValues.Add("ma_type", string(p.MAType))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// ULTOSCOptions - options for calling the twelvedata ultosc endpoint: https://twelvedata.com/docs#ultosc
+type ULTOSCOptions struct {
+	IndicatorOptions
+	TimePeriod1 int
+	TimePeriod2 int
+	TimePeriod3 int
+}
+
+func (o ULTOSCOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = o.IndicatorOptions.params(u, urlValues)
+
+	if o.TimePeriod1 > 0 {
+		urlValues.Add("time_period_1", strconv.Itoa(o.TimePeriod1))
+	}
+
+	if o.TimePeriod2 > 0 {
+		urlValues.Add("time_period_2", strconv.Itoa(o.TimePeriod2))
+	}
+
+	if o.TimePeriod3 > 0 {
+		urlValues.Add("time_period_3", strconv.Itoa(o.TimePeriod3))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// StochRSIOptions - options for calling the twelvedata stochrsi endpoint: https://twelvedata.com/docs#stochrsi
+type StochRSIOptions struct {
+	IndicatorOptions
+	RSILength   int
+	StochLength int
+	KPeriod     int
+	DPeriod     int
+}
+
+func (s StochRSIOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = s.IndicatorOptions.params(u, urlValues)
+
+	if s.RSILength > 0 {
+		urlValues.Add("rsi_length", strconv.Itoa(s.RSILength))
+	}
+
+	if s.StochLength > 0 {
+		urlValues.Add("stoch_length", strconv.Itoa(s.StochLength))
+	}
+
+	if s.KPeriod > 0 {
+		urlValues.Add("k_period", strconv.Itoa(s.KPeriod))
+	}
+
+	if s.DPeriod > 0 {
+		urlValues.Add("d_period", strconv.Itoa(s.DPeriod))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// StochFOptions - options for calling the twelvedata stochf endpoint: https://twelvedata.com/docs#stochf
+type StochFOptions struct {
+	IndicatorOptions
+	FastKPeriod int
+	FastDPeriod int
+	FastDMAType MAType
+}
+
+func (s StochFOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = s.IndicatorOptions.params(u, urlValues)
+
+	if s.FastKPeriod > 0 {
+		urlValues.Add("fast_k_period", strconv.Itoa(s.FastKPeriod))
+	}
+
+	if s.FastDPeriod > 0 {
+		urlValues.Add("fast_d_period", strconv.Itoa(s.FastDPeriod))
+	}
+
+	if s.FastDMAType != "" {
+		urlValues.Add("fast_dma_type", string(s.FastDMAType))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// CCIValue - the Indicator value for IndicatorResponse specific for CCI
+type CCIValue struct {
+	Datetime time.Time `json:"datetime"`
+	Cci      float64   `json:"cci"`
+}
+
+// UnmarshalJSON - unmarshal's CCIValue to a more consumable type
+func (c *CCIValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Cci      string `json:"cci"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	cci, err := parseFloat(value.Cci, "cci")
+	if err != nil {
+		return err
+	}
+
+	c.Datetime = dateTime
+	c.Cci = cci
+
+	return nil
+}
+
+func (c *CCIValue) inLocation(loc *time.Location) {
+	c.Datetime = model.InLocation(c.Datetime, loc)
+}
+
+// CCIRequest - builds a CCI request to queue on a batch.Batch
+func CCIRequest(symbol string, interval model.Interval, opts OscillatorOptions) batch.Request[IndicatorResponse[CCIValue, OscillatorIndicator]] {
+	return request[CCIValue, OscillatorIndicator]("cci", symbol, interval, opts)
+}
+
+// WILLRValue - the Indicator value for IndicatorResponse specific for WILLR
+type WILLRValue struct {
+	Datetime time.Time `json:"datetime"`
+	Willr    float64   `json:"willr"`
+}
+
+// UnmarshalJSON - unmarshal's WILLRValue to a more consumable type
+func (w *WILLRValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Willr    string `json:"willr"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	willr, err := parseFloat(value.Willr, "willr")
+	if err != nil {
+		return err
+	}
+
+	w.Datetime = dateTime
+	w.Willr = willr
+
+	return nil
+}
+
+func (w *WILLRValue) inLocation(loc *time.Location) {
+	w.Datetime = model.InLocation(w.Datetime, loc)
+}
+
+// WILLRRequest - builds a WILLR request to queue on a batch.Batch
+func WILLRRequest(symbol string, interval model.Interval, opts OscillatorOptions) batch.Request[IndicatorResponse[WILLRValue, OscillatorIndicator]] {
+	return request[WILLRValue, OscillatorIndicator]("willr", symbol, interval, opts)
+}
+
+// ROCValue - the Indicator value for IndicatorResponse specific for ROC
+type ROCValue struct {
+	Datetime time.Time `json:"datetime"`
+	Roc      float64   `json:"roc"`
+}
+
+// UnmarshalJSON - unmarshal's ROCValue to a more consumable type
+func (r *ROCValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Roc      string `json:"roc"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	roc, err := parseFloat(value.Roc, "roc")
+	if err != nil {
+		return err
+	}
+
+	r.Datetime = dateTime
+	r.Roc = roc
+
+	return nil
+}
+
+func (r *ROCValue) inLocation(loc *time.Location) {
+	r.Datetime = model.InLocation(r.Datetime, loc)
+}
+
+// ROCRequest - builds a ROC request to queue on a batch.Batch
+func ROCRequest(symbol string, interval model.Interval, opts OscillatorOptions) batch.Request[IndicatorResponse[ROCValue, OscillatorIndicator]] {
+	return request[ROCValue, OscillatorIndicator]("roc", symbol, interval, opts)
+}
+
+// ROCPValue - the Indicator value for IndicatorResponse specific for ROCP
+type ROCPValue struct {
+	Datetime time.Time `json:"datetime"`
+	Rocp     float64   `json:"rocp"`
+}
+
+// UnmarshalJSON - unmarshal's ROCPValue to a more consumable type
+func (r *ROCPValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Rocp     string `json:"rocp"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	rocp, err := parseFloat(value.Rocp, "rocp")
+	if err != nil {
+		return err
+	}
+
+	r.Datetime = dateTime
+	r.Rocp = rocp
+
+	return nil
+}
+
+func (r *ROCPValue) inLocation(loc *time.Location) {
+	r.Datetime = model.InLocation(r.Datetime, loc)
+}
+
+// ROCPRequest - builds a ROCP request to queue on a batch.Batch
+func ROCPRequest(symbol string, interval model.Interval, opts OscillatorOptions) batch.Request[IndicatorResponse[ROCPValue, OscillatorIndicator]] {
+	return request[ROCPValue, OscillatorIndicator]("rocp", symbol, interval, opts)
+}
+
+// ROCRValue - the Indicator value for IndicatorResponse specific for ROCR
+type ROCRValue struct {
+	Datetime time.Time `json:"datetime"`
+	Rocr     float64   `json:"rocr"`
+}
+
+// UnmarshalJSON - unmarshal's ROCRValue to a more consumable type
+func (r *ROCRValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Rocr     string `json:"rocr"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	rocr, err := parseFloat(value.Rocr, "rocr")
+	if err != nil {
+		return err
+	}
+
+	r.Datetime = dateTime
+	r.Rocr = rocr
+
+	return nil
+}
+
+func (r *ROCRValue) inLocation(loc *time.Location) {
+	r.Datetime = model.InLocation(r.Datetime, loc)
+}
+
+// ROCRRequest - builds a ROCR request to queue on a batch.Batch
+func ROCRRequest(symbol string, interval model.Interval, opts OscillatorOptions) batch.Request[IndicatorResponse[ROCRValue, OscillatorIndicator]] {
+	return request[ROCRValue, OscillatorIndicator]("rocr", symbol, interval, opts)
+}
+
+// MOMValue - the Indicator value for IndicatorResponse specific for MOM
+type MOMValue struct {
+	Datetime time.Time `json:"datetime"`
+	Mom      float64   `json:"mom"`
+}
+
+// UnmarshalJSON - unmarshal's MOMValue to a more consumable type
+func (m *MOMValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Mom      string `json:"mom"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	mom, err := parseFloat(value.Mom, "mom")
+	if err != nil {
+		return err
+	}
+
+	m.Datetime = dateTime
+	m.Mom = mom
+
+	return nil
+}
+
+func (m *MOMValue) inLocation(loc *time.Location) {
+	m.Datetime = model.InLocation(m.Datetime, loc)
+}
+
+// MOMRequest - builds a MOM request to queue on a batch.Batch
+func MOMRequest(symbol string, interval model.Interval, opts OscillatorOptions) batch.Request[IndicatorResponse[MOMValue, OscillatorIndicator]] {
+	return request[MOMValue, OscillatorIndicator]("mom", symbol, interval, opts)
+}
+
+// PPOValue - the Indicator value for IndicatorResponse specific for PPO
+type PPOValue struct {
+	Datetime time.Time `json:"datetime"`
+	Ppo      float64   `json:"ppo"`
+}
+
+// UnmarshalJSON - unmarshal's PPOValue to a more consumable type
+func (p *PPOValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Ppo      string `json:"ppo"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	ppo, err := parseFloat(value.Ppo, "ppo")
+	if err != nil {
+		return err
+	}
+
+	p.Datetime = dateTime
+	p.Ppo = ppo
+
+	return nil
+}
+
+func (p *PPOValue) inLocation(loc *time.Location) {
+	p.Datetime = model.InLocation(p.Datetime, loc)
+}
+
+// PPORequest - builds a PPO request to queue on a batch.Batch
+func PPORequest(symbol string, interval model.Interval, opts PriceOscillatorOptions) batch.Request[IndicatorResponse[PPOValue, PriceOscillatorIndicator]] {
+	return request[PPOValue, PriceOscillatorIndicator]("ppo", symbol, interval, opts)
+}
+
+// APOValue - the Indicator value for IndicatorResponse specific for APO
+type APOValue struct {
+	Datetime time.Time `json:"datetime"`
+	Apo      float64   `json:"apo"`
+}
+
+// UnmarshalJSON - unmarshal's APOValue to a more consumable type
+func (a *APOValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Apo      string `json:"apo"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	apo, err := parseFloat(value.Apo, "apo")
+	if err != nil {
+		return err
+	}
+
+	a.Datetime = dateTime
+	a.Apo = apo
+
+	return nil
+}
+
+func (a *APOValue) inLocation(loc *time.Location) {
+	a.Datetime = model.InLocation(a.Datetime, loc)
+}
+
+// APORequest - builds a APO request to queue on a batch.Batch
+func APORequest(symbol string, interval model.Interval, opts PriceOscillatorOptions) batch.Request[IndicatorResponse[APOValue, PriceOscillatorIndicator]] {
+	return request[APOValue, PriceOscillatorIndicator]("apo", symbol, interval, opts)
+}
+
+// ULTOSCValue - the Indicator value for IndicatorResponse specific for ULTOSC
+type ULTOSCValue struct {
+	Datetime time.Time `json:"datetime"`
+	Ultosc   float64   `json:"ultosc"`
+}
+
+// UnmarshalJSON - unmarshal's ULTOSCValue to a more consumable type
+func (u *ULTOSCValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Ultosc   string `json:"ultosc"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	ultosc, err := parseFloat(value.Ultosc, "ultosc")
+	if err != nil {
+		return err
+	}
+
+	u.Datetime = dateTime
+	u.Ultosc = ultosc
+
+	return nil
+}
+
+func (u *ULTOSCValue) inLocation(loc *time.Location) {
+	u.Datetime = model.InLocation(u.Datetime, loc)
+}
+
+// ULTOSCRequest - builds a ULTOSC request to queue on a batch.Batch
+func ULTOSCRequest(symbol string, interval model.Interval, opts ULTOSCOptions) batch.Request[IndicatorResponse[ULTOSCValue, ULTOSCIndicator]] {
+	return request[ULTOSCValue, ULTOSCIndicator]("ultosc", symbol, interval, opts)
+}
+
+// StochRSIValue - the Indicator value for IndicatorResponse specific for StochRSI
+type StochRSIValue struct {
+	Datetime time.Time `json:"datetime"`
+	K        float64   `json:"k"`
+	D        float64   `json:"d"`
+}
+
+// UnmarshalJSON - unmarshal's StochRSIValue to a more consumable type
+func (s *StochRSIValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		K        string `json:"k"`
+		D        string `json:"d"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	k, err := parseFloat(value.K, "k")
+	if err != nil {
+		return err
+	}
+
+	d, err := parseFloat(value.D, "d")
+	if err != nil {
+		return err
+	}
+
+	s.Datetime = dateTime
+	s.K = k
+	s.D = d
+
+	return nil
+}
+
+func (s *StochRSIValue) inLocation(loc *time.Location) {
+	s.Datetime = model.InLocation(s.Datetime, loc)
+}
+
+// StochRSIRequest - builds a StochRSI request to queue on a batch.Batch
+func StochRSIRequest(symbol string, interval model.Interval, opts StochRSIOptions) batch.Request[IndicatorResponse[StochRSIValue, StochRSIIndicator]] {
+	return request[StochRSIValue, StochRSIIndicator]("stochrsi", symbol, interval, opts)
+}
+
+// StochFValue - the Indicator value for IndicatorResponse specific for StochF
+type StochFValue struct {
+	Datetime time.Time `json:"datetime"`
+	FastK    float64   `json:"fast_k"`
+	FastD    float64   `json:"fast_d"`
+}
+
+// UnmarshalJSON - unmarshal's StochFValue to a more consumable type
+func (s *StochFValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		FastK    string `json:"fast_k"`
+		FastD    string `json:"fast_d"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	fastK, err := parseFloat(value.FastK, "fast_k")
+	if err != nil {
+		return err
+	}
+
+	fastD, err := parseFloat(value.FastD, "fast_d")
+	if err != nil {
+		return err
+	}
+
+	s.Datetime = dateTime
+	s.FastK = fastK
+	s.FastD = fastD
+
+	return nil
+}
+
+func (s *StochFValue) inLocation(loc *time.Location) {
+	s.Datetime = model.InLocation(s.Datetime, loc)
+}
+
+// StochFRequest - builds a StochF request to queue on a batch.Batch
+func StochFRequest(symbol string, interval model.Interval, opts StochFOptions) batch.Request[IndicatorResponse[StochFValue, StochFIndicator]] {
+	return request[StochFValue, StochFIndicator]("stochf", symbol, interval, opts)
+}
+
+// CCI - gets the Commodity Channel Index: https://twelvedata.com/docs#cci
+func (c *client) CCI(symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[CCIValue, OscillatorIndicator], error) {
+	return c.CCIWithContext(context.Background(), symbol, interval, opts)
+}
+
+// CCIWithContext - same as CCI, but bound to ctx
+func (c *client) CCIWithContext(ctx context.Context, symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[CCIValue, OscillatorIndicator], error) {
+	return indicator[CCIValue, OscillatorIndicator](ctx, c, "cci", symbol, interval, opts)
+}
+
+// WILLR - gets the Williams %R: https://twelvedata.com/docs#willr
+func (c *client) WILLR(symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[WILLRValue, OscillatorIndicator], error) {
+	return c.WILLRWithContext(context.Background(), symbol, interval, opts)
+}
+
+// WILLRWithContext - same as WILLR, but bound to ctx
+func (c *client) WILLRWithContext(ctx context.Context, symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[WILLRValue, OscillatorIndicator], error) {
+	return indicator[WILLRValue, OscillatorIndicator](ctx, c, "willr", symbol, interval, opts)
+}
+
+// ROC - gets the Rate of Change: https://twelvedata.com/docs#roc
+func (c *client) ROC(symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[ROCValue, OscillatorIndicator], error) {
+	return c.ROCWithContext(context.Background(), symbol, interval, opts)
+}
+
+// ROCWithContext - same as ROC, but bound to ctx
+func (c *client) ROCWithContext(ctx context.Context, symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[ROCValue, OscillatorIndicator], error) {
+	return indicator[ROCValue, OscillatorIndicator](ctx, c, "roc", symbol, interval, opts)
+}
+
+// ROCP - gets the Rate of Change Percentage: https://twelvedata.com/docs#rocp
+func (c *client) ROCP(symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[ROCPValue, OscillatorIndicator], error) {
+	return c.ROCPWithContext(context.Background(), symbol, interval, opts)
+}
+
+// ROCPWithContext - same as ROCP, but bound to ctx
+func (c *client) ROCPWithContext(ctx context.Context, symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[ROCPValue, OscillatorIndicator], error) {
+	return indicator[ROCPValue, OscillatorIndicator](ctx, c, "rocp", symbol, interval, opts)
+}
+
+// ROCR - gets the Rate of Change Ratio: https://twelvedata.com/docs#rocr
+func (c *client) ROCR(symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[ROCRValue, OscillatorIndicator], error) {
+	return c.ROCRWithContext(context.Background(), symbol, interval, opts)
+}
+
+// ROCRWithContext - same as ROCR, but bound to ctx
+func (c *client) ROCRWithContext(ctx context.Context, symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[ROCRValue, OscillatorIndicator], error) {
+	return indicator[ROCRValue, OscillatorIndicator](ctx, c, "rocr", symbol, interval, opts)
+}
+
+// MOM - gets the Momentum: https://twelvedata.com/docs#mom
+func (c *client) MOM(symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[MOMValue, OscillatorIndicator], error) {
+	return c.MOMWithContext(context.Background(), symbol, interval, opts)
+}
+
+// MOMWithContext - same as MOM, but bound to ctx
+func (c *client) MOMWithContext(ctx context.Context, symbol string, interval model.Interval, opts OscillatorOptions) (IndicatorResponse[MOMValue, OscillatorIndicator], error) {
+	return indicator[MOMValue, OscillatorIndicator](ctx, c, "mom", symbol, interval, opts)
+}
+
+// PPO - gets the Percentage Price Oscillator: https://twelvedata.com/docs#ppo
+func (c *client) PPO(symbol string, interval model.Interval, opts PriceOscillatorOptions) (IndicatorResponse[PPOValue, PriceOscillatorIndicator], error) {
+	return c.PPOWithContext(context.Background(), symbol, interval, opts)
+}
+
+// PPOWithContext - same as PPO, but bound to ctx
+func (c *client) PPOWithContext(ctx context.Context, symbol string, interval model.Interval, opts PriceOscillatorOptions) (IndicatorResponse[PPOValue, PriceOscillatorIndicator], error) {
+	return indicator[PPOValue, PriceOscillatorIndicator](ctx, c, "ppo", symbol, interval, opts)
+}
+
+// APO - gets the Absolute Price Oscillator: https://twelvedata.com/docs#apo
+func (c *client) APO(symbol string, interval model.Interval, opts PriceOscillatorOptions) (IndicatorResponse[APOValue, PriceOscillatorIndicator], error) {
+	return c.APOWithContext(context.Background(), symbol, interval, opts)
+}
+
+// APOWithContext - same as APO, but bound to ctx
+func (c *client) APOWithContext(ctx context.Context, symbol string, interval model.Interval, opts PriceOscillatorOptions) (IndicatorResponse[APOValue, PriceOscillatorIndicator], error) {
+	return indicator[APOValue, PriceOscillatorIndicator](ctx, c, "apo", symbol, interval, opts)
+}
+
+// ULTOSC - gets the Ultimate Oscillator: https://twelvedata.com/docs#ultosc
+func (c *client) ULTOSC(symbol string, interval model.Interval, opts ULTOSCOptions) (IndicatorResponse[ULTOSCValue, ULTOSCIndicator], error) {
+	return c.ULTOSCWithContext(context.Background(), symbol, interval, opts)
+}
+
+// ULTOSCWithContext - same as ULTOSC, but bound to ctx
+func (c *client) ULTOSCWithContext(ctx context.Context, symbol string, interval model.Interval, opts ULTOSCOptions) (IndicatorResponse[ULTOSCValue, ULTOSCIndicator], error) {
+	return indicator[ULTOSCValue, ULTOSCIndicator](ctx, c, "ultosc", symbol, interval, opts)
+}
+
+// StochRSI - gets the Stochastic RSI: https://twelvedata.com/docs#stochrsi
+func (c *client) StochRSI(symbol string, interval model.Interval, opts StochRSIOptions) (IndicatorResponse[StochRSIValue, StochRSIIndicator], error) {
+	return c.StochRSIWithContext(context.Background(), symbol, interval, opts)
+}
+
+// StochRSIWithContext - same as StochRSI, but bound to ctx
+func (c *client) StochRSIWithContext(ctx context.Context, symbol string, interval model.Interval, opts StochRSIOptions) (IndicatorResponse[StochRSIValue, StochRSIIndicator], error) {
+	return indicator[StochRSIValue, StochRSIIndicator](ctx, c, "stochrsi", symbol, interval, opts)
+}
+
+// StochF - gets the Stochastic Fast: https://twelvedata.com/docs#stochf
+func (c *client) StochF(symbol string, interval model.Interval, opts StochFOptions) (IndicatorResponse[StochFValue, StochFIndicator], error) {
+	return c.StochFWithContext(context.Background(), symbol, interval, opts)
+}
+
+// StochFWithContext - same as StochF, but bound to ctx
+func (c *client) StochFWithContext(ctx context.Context, symbol string, interval model.Interval, opts StochFOptions) (IndicatorResponse[StochFValue, StochFIndicator], error) {
+	return indicator[StochFValue, StochFIndicator](ctx, c, "stochf", symbol, interval, opts)
+}
@@ -0,0 +1,1063 @@
+package indicators
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	ppoBody      = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"PPO - Percentage Price Oscillator","series_type":"close","fast_period":12,"slow_period":26,"ma_type":"SMA"}},"values":[{"datetime":"2023-08-24","ppo":"-1.92352"},{"datetime":"2023-08-23","ppo":"-1.98071"},{"datetime":"2023-08-22","ppo":"-2.31238"}],"status":"ok"}`)
+	ultoscBody   = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"ULTOSC - Ultimate Oscillator","time_period_1":7,"time_period_2":14,"time_period_3":28}},"values":[{"datetime":"2023-08-24","ultosc":"39.42251"},{"datetime":"2023-08-23","ultosc":"49.61508"},{"datetime":"2023-08-22","ultosc":"41.03277"}],"status":"ok"}`)
+	stochRSIBody = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"STOCHRSI - Stochastic RSI","series_type":"close","rsi_length":14,"stoch_length":14,"k_period":3,"d_period":3}},"values":[{"datetime":"2023-08-24","k":"33.31904","d":"38.12745"}],"status":"ok"}`)
+	stochFBody   = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"STOCHF - Stochastic Fast","fast_k_period":14,"fast_d_period":3,"fast_dma_type":"SMA"}},"values":[{"datetime":"2023-08-24","fast_k":"19.33884","fast_d":"32.05251"}],"status":"ok"}`)
+	cciBody      = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"CCI - Commodity Channel Index","time_period":20}},"values":[{"datetime":"2023-08-24","cci":"-64.14792"},{"datetime":"2023-08-23","cci":"6.08731"},{"datetime":"2023-08-22","cci":"-59.96011"}],"status":"ok"}`)
+	willrBody    = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"WILLR - Williams %R","time_period":14}},"values":[{"datetime":"2023-08-24","willr":"-85.15206"},{"datetime":"2023-08-23","willr":"-49.29054"},{"datetime":"2023-08-22","willr":"-80.68586"}],"status":"ok"}`)
+	rocBody      = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"ROC - Rate of change","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","roc":"-1.58801"},{"datetime":"2023-08-23","roc":"0.94493"},{"datetime":"2023-08-22","roc":"-0.34853"}],"status":"ok"}`)
+	rocpBody     = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"ROCP - Rate of change percentage","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","rocp":"-0.01588"},{"datetime":"2023-08-23","rocp":"0.00945"},{"datetime":"2023-08-22","rocp":"-0.00349"}],"status":"ok"}`)
+	rocrBody     = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"ROCR - Rate of change ratio","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","rocr":"0.98412"},{"datetime":"2023-08-23","rocr":"1.00945"},{"datetime":"2023-08-22","rocr":"0.99651"}],"status":"ok"}`)
+	momBody      = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"MOM - Momentum","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","mom":"-2.84600"},{"datetime":"2023-08-23","mom":"1.69550"},{"datetime":"2023-08-22","mom":"-0.62000"}],"status":"ok"}`)
+	apoBody      = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"APO - Absolute Price Oscillator","series_type":"close","fast_period":12,"slow_period":26,"ma_type":"SMA"}},"values":[{"datetime":"2023-08-24","apo":"-3.51923"},{"datetime":"2023-08-23","apo":"-3.62404"},{"datetime":"2023-08-22","apo":"-4.23154"}],"status":"ok"}`)
+)
+
+func TestIntegrationCCI(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	_, err := client.CCI("AAPL", model.OneHour, OscillatorOptions{})
+	if err != nil {
+		t.Log("Failed to make CCI request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitCCI(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  OscillatorOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator OscillatorIndicator
+		values    int
+		first     CCIValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","cci":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value cci into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return cciBody, nil
+				},
+				opts: OscillatorOptions{TimePeriod: 20},
+			},
+			want{
+				params:    url.Values{"time_period": {"20"}},
+				indicator: OscillatorIndicator{Name: "CCI - Commodity Channel Index", TimePeriod: 20},
+				values:    3,
+				first:     CCIValue{Datetime: inNewYork(2023, time.August, 24), Cci: -64.14792},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.CCI("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/cci", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitWILLR(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  OscillatorOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator OscillatorIndicator
+		values    int
+		first     WILLRValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","willr":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value willr into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return willrBody, nil
+				},
+			},
+			want{
+				indicator: OscillatorIndicator{Name: "WILLR - Williams %R", TimePeriod: 14},
+				values:    3,
+				first:     WILLRValue{Datetime: inNewYork(2023, time.August, 24), Willr: -85.15206},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.WILLR("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/willr", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitROC(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  OscillatorOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator OscillatorIndicator
+		values    int
+		first     ROCValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","roc":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value roc into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return rocBody, nil
+				},
+			},
+			want{
+				indicator: OscillatorIndicator{Name: "ROC - Rate of change", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     ROCValue{Datetime: inNewYork(2023, time.August, 24), Roc: -1.58801},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.ROC("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/roc", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitROCP(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  OscillatorOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator OscillatorIndicator
+		values    int
+		first     ROCPValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","rocp":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value rocp into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return rocpBody, nil
+				},
+			},
+			want{
+				indicator: OscillatorIndicator{Name: "ROCP - Rate of change percentage", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     ROCPValue{Datetime: inNewYork(2023, time.August, 24), Rocp: -0.01588},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.ROCP("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/rocp", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitROCR(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  OscillatorOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator OscillatorIndicator
+		values    int
+		first     ROCRValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","rocr":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value rocr into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return rocrBody, nil
+				},
+			},
+			want{
+				indicator: OscillatorIndicator{Name: "ROCR - Rate of change ratio", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     ROCRValue{Datetime: inNewYork(2023, time.August, 24), Rocr: 0.98412},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.ROCR("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/rocr", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitMOM(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  OscillatorOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator OscillatorIndicator
+		values    int
+		first     MOMValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","mom":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value mom into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return momBody, nil
+				},
+			},
+			want{
+				indicator: OscillatorIndicator{Name: "MOM - Momentum", SeriesType: "close", TimePeriod: 9},
+				values:    3,
+				first:     MOMValue{Datetime: inNewYork(2023, time.August, 24), Mom: -2.846},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.MOM("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/mom", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitPPO(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  PriceOscillatorOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator PriceOscillatorIndicator
+		values    int
+		first     PPOValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","ppo":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value ppo into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return ppoBody, nil
+				},
+				opts: PriceOscillatorOptions{FastPeriod: 12, SlowPeriod: 26, MAType: MATypeSMA},
+			},
+			want{
+				params:    url.Values{"fast_period": {"12"}, "slow_period": {"26"}, "ma_type": {"SMA"}},
+				indicator: PriceOscillatorIndicator{Name: "PPO - Percentage Price Oscillator", SeriesType: "close", FastPeriod: 12, SlowPeriod: 26, MAType: "SMA"},
+				values:    3,
+				first:     PPOValue{Datetime: inNewYork(2023, time.August, 24), Ppo: -1.92352},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.PPO("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/ppo", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitAPO(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  PriceOscillatorOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator PriceOscillatorIndicator
+		values    int
+		first     APOValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","apo":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value apo into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return apoBody, nil
+				},
+			},
+			want{
+				indicator: PriceOscillatorIndicator{Name: "APO - Absolute Price Oscillator", SeriesType: "close", FastPeriod: 12, SlowPeriod: 26, MAType: "SMA"},
+				values:    3,
+				first:     APOValue{Datetime: inNewYork(2023, time.August, 24), Apo: -3.51923},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.APO("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/apo", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitULTOSC(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  ULTOSCOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator ULTOSCIndicator
+		values    int
+		first     ULTOSCValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","ultosc":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value ultosc into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return ultoscBody, nil
+				},
+				opts: ULTOSCOptions{TimePeriod1: 7, TimePeriod2: 14, TimePeriod3: 28},
+			},
+			want{
+				params:    url.Values{"time_period_1": {"7"}, "time_period_3": {"28"}},
+				indicator: ULTOSCIndicator{Name: "ULTOSC - Ultimate Oscillator", TimePeriod1: 7, TimePeriod2: 14, TimePeriod3: 28},
+				values:    3,
+				first:     ULTOSCValue{Datetime: inNewYork(2023, time.August, 24), Ultosc: 39.42251},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.ULTOSC("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/ultosc", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitStochRSI(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  StochRSIOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator StochRSIIndicator
+		values    int
+		first     StochRSIValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","k":"abc","d":"1.5"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value k into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return stochRSIBody, nil
+				},
+				opts: StochRSIOptions{RSILength: 14, StochLength: 14, KPeriod: 3, DPeriod: 3},
+			},
+			want{
+				params:    url.Values{"rsi_length": {"14"}, "k_period": {"3"}},
+				indicator: StochRSIIndicator{Name: "STOCHRSI - Stochastic RSI", SeriesType: "close", RSILength: 14, StochLength: 14, KPeriod: 3, DPeriod: 3},
+				values:    1,
+				first:     StochRSIValue{Datetime: inNewYork(2023, time.August, 24), K: 33.31904, D: 38.12745},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.StochRSI("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/stochrsi", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitStochF(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  StochFOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator StochFIndicator
+		values    int
+		first     StochFValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","fast_k":"abc","fast_d":"1.5"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value fast_k into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return stochFBody, nil
+				},
+				opts: StochFOptions{FastKPeriod: 14, FastDMAType: MATypeSMA},
+			},
+			want{
+				params:    url.Values{"fast_k_period": {"14"}, "fast_dma_type": {"SMA"}},
+				indicator: StochFIndicator{Name: "STOCHF - Stochastic Fast", FastKPeriod: 14, FastDPeriod: 3, FastDMAType: "SMA"},
+				values:    1,
+				first:     StochFValue{Datetime: inNewYork(2023, time.August, 24), FastK: 19.33884, FastD: 32.05251},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.StochF("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/stochf", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,657 @@
+package indicators
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+)
+
+// IchimokuIndicator - the Indicator value for IndicatorMeta specific for Ichimoku
+type IchimokuIndicator struct {
+	Name                   string `json:"name"`
+	ConversionLinePeriod   int    `json:"conversion_line_period"`
+	BaseLinePeriod         int    `json:"base_line_period"`
+	LeadingSpanBPeriod     int    `json:"leading_span_b_period"`
+	LaggingSpanPeriod      int    `json:"lagging_span_period"`
+	IncludeAheadSpanPeriod bool   `json:"include_ahead_span_period"`
+}
+
+// OverlayIndicator - the Indicator value for IndicatorMeta shared by the overlays with only a time period
+type OverlayIndicator struct {
+	Name       string `json:"name"`
+	SeriesType string `json:"series_type"`
+	TimePeriod int    `json:"time_period"`
+}
+
+// HTTrendlineIndicator - the Indicator value for IndicatorMeta specific for HTTrendline
+type HTTrendlineIndicator struct {
+	Name       string `json:"name"`
+	SeriesType string `json:"series_type"`
+}
+
+// IchimokuOptions - options for calling the twelvedata ichimoku endpoint: https://twelvedata.com/docs#ichimoku
+type IchimokuOptions struct {
+	IndicatorOptions
+	ConversionLinePeriod int
+	BaseLinePeriod       int
+	LeadingSpanBPeriod   int
+	LaggingSpanPeriod    int
+	// IncludeAheadSpanPeriod - also returns the senkou spans projected ahead of the latest bar
+	IncludeAheadSpanPeriod bool
+}
+
+func (i IchimokuOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = i.IndicatorOptions.params(u, urlValues)
+
+	if i.ConversionLinePeriod > 0 {
+		urlValues.Add("conversion_line_period", strconv.Itoa(i.ConversionLinePeriod))
+	}
+
+	if i.BaseLinePeriod > 0 {
+		urlValues.Add("base_line_period", strconv.Itoa(i.BaseLinePeriod))
+	}
+
+	if i.LeadingSpanBPeriod > 0 {
+		urlValues.Add("leading_span_b_period", strconv.Itoa(i.LeadingSpanBPeriod))
+	}
+
+	if i.LaggingSpanPeriod > 0 {
+		urlValues.Add("lagging_span_period", strconv.Itoa(i.LaggingSpanPeriod))
+	}
+
+	if i.IncludeAheadSpanPeriod {
+		urlValues.Add("include_ahead_span_period", "true")
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// OverlayOptions - options for calling the twelvedata overlay endpoints with only a time period, e.g.
+// https://twelvedata.com/docs#midpoint
+type OverlayOptions struct {
+	IndicatorOptions
+	TimePeriod int
+}
+
+func (o OverlayOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = o.IndicatorOptions.params(u, urlValues)
+
+	if o.TimePeriod > 0 {
+		urlValues.Add("time_period", strconv.Itoa(o.TimePeriod))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// HTTrendlineOptions - options for calling the twelvedata ht_trendline endpoint: https://twelvedata.com/docs#ht_trendline
+type HTTrendlineOptions struct {
+	IndicatorOptions
+}
+
+func (h HTTrendlineOptions) params(u *url.URL, urlValues url.Values) {
+	u.RawQuery = h.IndicatorOptions.params(u, urlValues).Encode()
+}
+
+// IchimokuValue - the Indicator value for IndicatorResponse specific for Ichimoku, lines the API has no value for
+// on the earliest or projected bars are nil
+type IchimokuValue struct {
+	Datetime    time.Time `json:"datetime"`
+	TenkanSen   *float64  `json:"tenkan_sen"`
+	KijunSen    *float64  `json:"kijun_sen"`
+	SenkouSpanA *float64  `json:"senkou_span_a"`
+	SenkouSpanB *float64  `json:"senkou_span_b"`
+	ChikouSpan  *float64  `json:"chikou_span"`
+}
+
+// UnmarshalJSON - unmarshal's IchimokuValue to a more consumable type
+func (i *IchimokuValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime    string `json:"datetime"`
+		TenkanSen   string `json:"tenkan_sen"`
+		KijunSen    string `json:"kijun_sen"`
+		SenkouSpanA string `json:"senkou_span_a"`
+		SenkouSpanB string `json:"senkou_span_b"`
+		ChikouSpan  string `json:"chikou_span"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	tenkanSen, err := parseOptionalFloat(value.TenkanSen, "tenkan_sen")
+	if err != nil {
+		return err
+	}
+
+	kijunSen, err := parseOptionalFloat(value.KijunSen, "kijun_sen")
+	if err != nil {
+		return err
+	}
+
+	senkouSpanA, err := parseOptionalFloat(value.SenkouSpanA, "senkou_span_a")
+	if err != nil {
+		return err
+	}
+
+	senkouSpanB, err := parseOptionalFloat(value.SenkouSpanB, "senkou_span_b")
+	if err != nil {
+		return err
+	}
+
+	chikouSpan, err := parseOptionalFloat(value.ChikouSpan, "chikou_span")
+	if err != nil {
+		return err
+	}
+
+	i.Datetime = dateTime
+	i.TenkanSen = tenkanSen
+	i.KijunSen = kijunSen
+	i.SenkouSpanA = senkouSpanA
+	i.SenkouSpanB = senkouSpanB
+	i.ChikouSpan = chikouSpan
+
+	return nil
+}
+
+func (i *IchimokuValue) inLocation(loc *time.Location) {
+	i.Datetime = model.InLocation(i.Datetime, loc)
+}
+
+// IchimokuRequest - builds a Ichimoku request to queue on a batch.Batch
+func IchimokuRequest(symbol string, interval model.Interval, opts IchimokuOptions) batch.Request[IndicatorResponse[IchimokuValue, IchimokuIndicator]] {
+	return request[IchimokuValue, IchimokuIndicator]("ichimoku", symbol, interval, opts)
+}
+
+// PivotPointsHLValue - the Indicator value for IndicatorResponse specific for PivotPointsHL, nil on the bars the API
+// has no value for yet
+type PivotPointsHLValue struct {
+	Datetime    time.Time `json:"datetime"`
+	PivotPointH *float64  `json:"pivot_point_h"`
+	PivotPointL *float64  `json:"pivot_point_l"`
+}
+
+// UnmarshalJSON - unmarshal's PivotPointsHLValue to a more consumable type
+func (p *PivotPointsHLValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime    string `json:"datetime"`
+		PivotPointH string `json:"pivot_point_h"`
+		PivotPointL string `json:"pivot_point_l"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	pivotPointH, err := parseOptionalFloat(value.PivotPointH, "pivot_point_h")
+	if err != nil {
+		return err
+	}
+
+	pivotPointL, err := parseOptionalFloat(value.PivotPointL, "pivot_point_l")
+	if err != nil {
+		return err
+	}
+
+	p.Datetime = dateTime
+	p.PivotPointH = pivotPointH
+	p.PivotPointL = pivotPointL
+
+	return nil
+}
+
+func (p *PivotPointsHLValue) inLocation(loc *time.Location) {
+	p.Datetime = model.InLocation(p.Datetime, loc)
+}
+
+// PivotPointsHLRequest - builds a PivotPointsHL request to queue on a batch.Batch
+func PivotPointsHLRequest(symbol string, interval model.Interval, opts OverlayOptions) batch.Request[IndicatorResponse[PivotPointsHLValue, OverlayIndicator]] {
+	return request[PivotPointsHLValue, OverlayIndicator]("pivot_points_hl", symbol, interval, opts)
+}
+
+// MidPointValue - the Indicator value for IndicatorResponse specific for MidPoint, nil on the bars the API has no value
+// for yet
+type MidPointValue struct {
+	Datetime time.Time `json:"datetime"`
+	MidPoint *float64  `json:"midpoint"`
+}
+
+// UnmarshalJSON - unmarshal's MidPointValue to a more consumable type
+func (m *MidPointValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		MidPoint string `json:"midpoint"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	midPoint, err := parseOptionalFloat(value.MidPoint, "midpoint")
+	if err != nil {
+		return err
+	}
+
+	m.Datetime = dateTime
+	m.MidPoint = midPoint
+
+	return nil
+}
+
+func (m *MidPointValue) inLocation(loc *time.Location) {
+	m.Datetime = model.InLocation(m.Datetime, loc)
+}
+
+// MidPointRequest - builds a MidPoint request to queue on a batch.Batch
+func MidPointRequest(symbol string, interval model.Interval, opts OverlayOptions) batch.Request[IndicatorResponse[MidPointValue, OverlayIndicator]] {
+	return request[MidPointValue, OverlayIndicator]("midpoint", symbol, interval, opts)
+}
+
+// MidPriceValue - the Indicator value for IndicatorResponse specific for MidPrice, nil on the bars the API has no value
+// for yet
+type MidPriceValue struct {
+	Datetime time.Time `json:"datetime"`
+	MidPrice *float64  `json:"midprice"`
+}
+
+// UnmarshalJSON - unmarshal's MidPriceValue to a more consumable type
+func (m *MidPriceValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		MidPrice string `json:"midprice"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	midPrice, err := parseOptionalFloat(value.MidPrice, "midprice")
+	if err != nil {
+		return err
+	}
+
+	m.Datetime = dateTime
+	m.MidPrice = midPrice
+
+	return nil
+}
+
+func (m *MidPriceValue) inLocation(loc *time.Location) {
+	m.Datetime = model.InLocation(m.Datetime, loc)
+}
+
+// MidPriceRequest - builds a MidPrice request to queue on a batch.Batch
+func MidPriceRequest(symbol string, interval model.Interval, opts OverlayOptions) batch.Request[IndicatorResponse[MidPriceValue, OverlayIndicator]] {
+	return request[MidPriceValue, OverlayIndicator]("midprice", symbol, interval, opts)
+}
+
+// HTTrendlineValue - the Indicator value for IndicatorResponse specific for HTTrendline, nil on the bars the API has no
+// value for yet
+type HTTrendlineValue struct {
+	Datetime    time.Time `json:"datetime"`
+	HTTrendline *float64  `json:"ht_trendline"`
+}
+
+// UnmarshalJSON - unmarshal's HTTrendlineValue to a more consumable type
+func (h *HTTrendlineValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime    string `json:"datetime"`
+		HTTrendline string `json:"ht_trendline"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	hTTrendline, err := parseOptionalFloat(value.HTTrendline, "ht_trendline")
+	if err != nil {
+		return err
+	}
+
+	h.Datetime = dateTime
+	h.HTTrendline = hTTrendline
+
+	return nil
+}
+
+func (h *HTTrendlineValue) inLocation(loc *time.Location) {
+	h.Datetime = model.InLocation(h.Datetime, loc)
+}
+
+// HTTrendlineRequest - builds a HTTrendline request to queue on a batch.Batch
+func HTTrendlineRequest(symbol string, interval model.Interval, opts HTTrendlineOptions) batch.Request[IndicatorResponse[HTTrendlineValue, HTTrendlineIndicator]] {
+	return request[HTTrendlineValue, HTTrendlineIndicator]("ht_trendline", symbol, interval, opts)
+}
+
+// LinearRegValue - the Indicator value for IndicatorResponse specific for LinearReg, nil on the bars the API has no
+// value for yet
+type LinearRegValue struct {
+	Datetime  time.Time `json:"datetime"`
+	LinearReg *float64  `json:"linearreg"`
+}
+
+// UnmarshalJSON - unmarshal's LinearRegValue to a more consumable type
+func (l *LinearRegValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime  string `json:"datetime"`
+		LinearReg string `json:"linearreg"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	linearReg, err := parseOptionalFloat(value.LinearReg, "linearreg")
+	if err != nil {
+		return err
+	}
+
+	l.Datetime = dateTime
+	l.LinearReg = linearReg
+
+	return nil
+}
+
+func (l *LinearRegValue) inLocation(loc *time.Location) {
+	l.Datetime = model.InLocation(l.Datetime, loc)
+}
+
+// LinearRegRequest - builds a LinearReg request to queue on a batch.Batch
+func LinearRegRequest(symbol string, interval model.Interval, opts OverlayOptions) batch.Request[IndicatorResponse[LinearRegValue, OverlayIndicator]] {
+	return request[LinearRegValue, OverlayIndicator]("linearreg", symbol, interval, opts)
+}
+
+// LinearRegAngleValue - the Indicator value for IndicatorResponse specific for LinearRegAngle, nil on the bars the API
+// has no value for yet
+type LinearRegAngleValue struct {
+	Datetime       time.Time `json:"datetime"`
+	LinearRegAngle *float64  `json:"linearregangle"`
+}
+
+// UnmarshalJSON - unmarshal's LinearRegAngleValue to a more consumable type
+func (l *LinearRegAngleValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime       string `json:"datetime"`
+		LinearRegAngle string `json:"linearregangle"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	linearRegAngle, err := parseOptionalFloat(value.LinearRegAngle, "linearregangle")
+	if err != nil {
+		return err
+	}
+
+	l.Datetime = dateTime
+	l.LinearRegAngle = linearRegAngle
+
+	return nil
+}
+
+func (l *LinearRegAngleValue) inLocation(loc *time.Location) {
+	l.Datetime = model.InLocation(l.Datetime, loc)
+}
+
+// LinearRegAngleRequest - builds a LinearRegAngle request to queue on a batch.Batch
+func LinearRegAngleRequest(symbol string, interval model.Interval, opts OverlayOptions) batch.Request[IndicatorResponse[LinearRegAngleValue, OverlayIndicator]] {
+	return request[LinearRegAngleValue, OverlayIndicator]("linearregangle", symbol, interval, opts)
+}
+
+// LinearRegInterceptValue - the Indicator value for IndicatorResponse specific for LinearRegIntercept, nil on the bars
+// the API has no value for yet
+type LinearRegInterceptValue struct {
+	Datetime           time.Time `json:"datetime"`
+	LinearRegIntercept *float64  `json:"linearregintercept"`
+}
+
+// UnmarshalJSON - unmarshal's LinearRegInterceptValue to a more consumable type
+func (l *LinearRegInterceptValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime           string `json:"datetime"`
+		LinearRegIntercept string `json:"linearregintercept"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	linearRegIntercept, err := parseOptionalFloat(value.LinearRegIntercept, "linearregintercept")
+	if err != nil {
+		return err
+	}
+
+	l.Datetime = dateTime
+	l.LinearRegIntercept = linearRegIntercept
+
+	return nil
+}
+
+func (l *LinearRegInterceptValue) inLocation(loc *time.Location) {
+	l.Datetime = model.InLocation(l.Datetime, loc)
+}
+
+// LinearRegInterceptRequest - builds a LinearRegIntercept request to queue on a batch.Batch
+func LinearRegInterceptRequest(symbol string, interval model.Interval, opts OverlayOptions) batch.Request[IndicatorResponse[LinearRegInterceptValue, OverlayIndicator]] {
+	return request[LinearRegInterceptValue, OverlayIndicator]("linearregintercept", symbol, interval, opts)
+}
+
+// LinearRegSlopeValue - the Indicator value for IndicatorResponse specific for LinearRegSlope, nil on the bars the API
+// has no value for yet
+type LinearRegSlopeValue struct {
+	Datetime       time.Time `json:"datetime"`
+	LinearRegSlope *float64  `json:"linearregslope"`
+}
+
+// UnmarshalJSON - unmarshal's LinearRegSlopeValue to a more consumable type
+func (l *LinearRegSlopeValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime       string `json:"datetime"`
+		LinearRegSlope string `json:"linearregslope"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	linearRegSlope, err := parseOptionalFloat(value.LinearRegSlope, "linearregslope")
+	if err != nil {
+		return err
+	}
+
+	l.Datetime = dateTime
+	l.LinearRegSlope = linearRegSlope
+
+	return nil
+}
+
+func (l *LinearRegSlopeValue) inLocation(loc *time.Location) {
+	l.Datetime = model.InLocation(l.Datetime, loc)
+}
+
+// LinearRegSlopeRequest - builds a LinearRegSlope request to queue on a batch.Batch
+func LinearRegSlopeRequest(symbol string, interval model.Interval, opts OverlayOptions) batch.Request[IndicatorResponse[LinearRegSlopeValue, OverlayIndicator]] {
+	return request[LinearRegSlopeValue, OverlayIndicator]("linearregslope", symbol, interval, opts)
+}
+
+// TSFValue - the Indicator value for IndicatorResponse specific for TSF, nil on the bars the API has no value for yet
+type TSFValue struct {
+	Datetime time.Time `json:"datetime"`
+	Tsf      *float64  `json:"tsf"`
+}
+
+// UnmarshalJSON - unmarshal's TSFValue to a more consumable type
+func (t *TSFValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Tsf      string `json:"tsf"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	tsf, err := parseOptionalFloat(value.Tsf, "tsf")
+	if err != nil {
+		return err
+	}
+
+	t.Datetime = dateTime
+	t.Tsf = tsf
+
+	return nil
+}
+
+func (t *TSFValue) inLocation(loc *time.Location) {
+	t.Datetime = model.InLocation(t.Datetime, loc)
+}
+
+// TSFRequest - builds a TSF request to queue on a batch.Batch
+func TSFRequest(symbol string, interval model.Interval, opts OverlayOptions) batch.Request[IndicatorResponse[TSFValue, OverlayIndicator]] {
+	return request[TSFValue, OverlayIndicator]("tsf", symbol, interval, opts)
+}
+
+// Ichimoku - gets the Ichimoku Kinkō Hyō: https://twelvedata.com/docs#ichimoku
+func (c *client) Ichimoku(symbol string, interval model.Interval, opts IchimokuOptions) (IndicatorResponse[IchimokuValue, IchimokuIndicator], error) {
+	return c.IchimokuWithContext(context.Background(), symbol, interval, opts)
+}
+
+// IchimokuWithContext - same as Ichimoku, but bound to ctx
+func (c *client) IchimokuWithContext(ctx context.Context, symbol string, interval model.Interval, opts IchimokuOptions) (IndicatorResponse[IchimokuValue, IchimokuIndicator], error) {
+	return indicator[IchimokuValue, IchimokuIndicator](ctx, c, "ichimoku", symbol, interval, opts)
+}
+
+// PivotPointsHL - gets the Pivot Points (High/Low): https://twelvedata.com/docs#pivot_points_hl
+func (c *client) PivotPointsHL(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[PivotPointsHLValue, OverlayIndicator], error) {
+	return c.PivotPointsHLWithContext(context.Background(), symbol, interval, opts)
+}
+
+// PivotPointsHLWithContext - same as PivotPointsHL, but bound to ctx
+func (c *client) PivotPointsHLWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[PivotPointsHLValue, OverlayIndicator], error) {
+	return indicator[PivotPointsHLValue, OverlayIndicator](ctx, c, "pivot_points_hl", symbol, interval, opts)
+}
+
+// MidPoint - gets the MidPoint over period: https://twelvedata.com/docs#midpoint
+func (c *client) MidPoint(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[MidPointValue, OverlayIndicator], error) {
+	return c.MidPointWithContext(context.Background(), symbol, interval, opts)
+}
+
+// MidPointWithContext - same as MidPoint, but bound to ctx
+func (c *client) MidPointWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[MidPointValue, OverlayIndicator], error) {
+	return indicator[MidPointValue, OverlayIndicator](ctx, c, "midpoint", symbol, interval, opts)
+}
+
+// MidPrice - gets the Midpoint Price over period: https://twelvedata.com/docs#midprice
+func (c *client) MidPrice(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[MidPriceValue, OverlayIndicator], error) {
+	return c.MidPriceWithContext(context.Background(), symbol, interval, opts)
+}
+
+// MidPriceWithContext - same as MidPrice, but bound to ctx
+func (c *client) MidPriceWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[MidPriceValue, OverlayIndicator], error) {
+	return indicator[MidPriceValue, OverlayIndicator](ctx, c, "midprice", symbol, interval, opts)
+}
+
+// HTTrendline - gets the Hilbert Transform Instantaneous Trendline: https://twelvedata.com/docs#ht_trendline
+func (c *client) HTTrendline(symbol string, interval model.Interval, opts HTTrendlineOptions) (IndicatorResponse[HTTrendlineValue, HTTrendlineIndicator], error) {
+	return c.HTTrendlineWithContext(context.Background(), symbol, interval, opts)
+}
+
+// HTTrendlineWithContext - same as HTTrendline, but bound to ctx
+func (c *client) HTTrendlineWithContext(ctx context.Context, symbol string, interval model.Interval, opts HTTrendlineOptions) (IndicatorResponse[HTTrendlineValue, HTTrendlineIndicator], error) {
+	return indicator[HTTrendlineValue, HTTrendlineIndicator](ctx, c, "ht_trendline", symbol, interval, opts)
+}
+
+// LinearReg - gets the Linear Regression: https://twelvedata.com/docs#linearreg
+func (c *client) LinearReg(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegValue, OverlayIndicator], error) {
+	return c.LinearRegWithContext(context.Background(), symbol, interval, opts)
+}
+
+// LinearRegWithContext - same as LinearReg, but bound to ctx
+func (c *client) LinearRegWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegValue, OverlayIndicator], error) {
+	return indicator[LinearRegValue, OverlayIndicator](ctx, c, "linearreg", symbol, interval, opts)
+}
+
+// LinearRegAngle - gets the Linear Regression Angle: https://twelvedata.com/docs#linearregangle
+func (c *client) LinearRegAngle(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegAngleValue, OverlayIndicator], error) {
+	return c.LinearRegAngleWithContext(context.Background(), symbol, interval, opts)
+}
+
+// LinearRegAngleWithContext - same as LinearRegAngle, but bound to ctx
+func (c *client) LinearRegAngleWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegAngleValue, OverlayIndicator], error) {
+	return indicator[LinearRegAngleValue, OverlayIndicator](ctx, c, "linearregangle", symbol, interval, opts)
+}
+
+// LinearRegIntercept - gets the Linear Regression Intercept: https://twelvedata.com/docs#linearregintercept
+func (c *client) LinearRegIntercept(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegInterceptValue, OverlayIndicator], error) {
+	return c.LinearRegInterceptWithContext(context.Background(), symbol, interval, opts)
+}
+
+// LinearRegInterceptWithContext - same as LinearRegIntercept, but bound to ctx
+func (c *client) LinearRegInterceptWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegInterceptValue, OverlayIndicator], error) {
+	return indicator[LinearRegInterceptValue, OverlayIndicator](ctx, c, "linearregintercept", symbol, interval, opts)
+}
+
+// LinearRegSlope - gets the Linear Regression Slope: https://twelvedata.com/docs#linearregslope
+func (c *client) LinearRegSlope(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegSlopeValue, OverlayIndicator], error) {
+	return c.LinearRegSlopeWithContext(context.Background(), symbol, interval, opts)
+}
+
+// LinearRegSlopeWithContext - same as LinearRegSlope, but bound to ctx
+func (c *client) LinearRegSlopeWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[LinearRegSlopeValue, OverlayIndicator], error) {
+	return indicator[LinearRegSlopeValue, OverlayIndicator](ctx, c, "linearregslope", symbol, interval, opts)
+}
+
+// TSF - gets the Time Series Forecast: https://twelvedata.com/docs#tsf
+func (c *client) TSF(symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[TSFValue, OverlayIndicator], error) {
+	return c.TSFWithContext(context.Background(), symbol, interval, opts)
+}
+
+// TSFWithContext - same as TSF, but bound to ctx
+func (c *client) TSFWithContext(ctx context.Context, symbol string, interval model.Interval, opts OverlayOptions) (IndicatorResponse[TSFValue, OverlayIndicator], error) {
+	return indicator[TSFValue, OverlayIndicator](ctx, c, "tsf", symbol, interval, opts)
+}
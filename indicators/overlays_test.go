@@ -0,0 +1,996 @@
+package indicators
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	ichimokuBody           = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"ICHIMOKU - Ichimoku Kinkō Hyō","conversion_line_period":9,"base_line_period":26,"leading_span_b_period":52,"lagging_span_period":26,"include_ahead_span_period":true}},"values":[{"datetime":"2023-09-29","tenkan_sen":"","kijun_sen":"","senkou_span_a":"186.50250","senkou_span_b":"183.64500","chikou_span":""},{"datetime":"2023-08-24","tenkan_sen":"178.75500","kijun_sen":"184.03000","senkou_span_a":"189.26500","senkou_span_b":"184.04500","chikou_span":"176.38000"}],"status":"ok"}`)
+	pivotBody              = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"PIVOT_POINTS_HL - Pivot Points (High/Low)","time_period":10}},"values":[{"datetime":"2023-08-24","pivot_point_h":"0","pivot_point_l":"1"},{"datetime":"2023-08-23","pivot_point_h":"","pivot_point_l":""}],"status":"ok"}`)
+	midPointBody           = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"MIDPOINT - MidPoint over period","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","midpoint":"178.75500"},{"datetime":"2023-08-23","midpoint":"178.75500"},{"datetime":"2023-08-22","midpoint":"178.14000"},{"datetime":"2023-08-21","midpoint":""}],"status":"ok"}`)
+	midPriceBody           = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"MIDPRICE - Midpoint Price over period","time_period":9}},"values":[{"datetime":"2023-08-24","midprice":"178.75500"},{"datetime":"2023-08-23","midprice":"178.75500"},{"datetime":"2023-08-22","midprice":"178.79000"},{"datetime":"2023-08-21","midprice":""}],"status":"ok"}`)
+	htTrendlineBody        = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"HT_TRENDLINE - Hilbert Transform Instantaneous Trendline","series_type":"high"}},"values":[{"datetime":"2023-08-24","ht_trendline":"180.28213"},{"datetime":"2023-08-23","ht_trendline":"180.59740"},{"datetime":"2023-08-22","ht_trendline":"180.94861"},{"datetime":"2023-08-21","ht_trendline":""}],"status":"ok"}`)
+	linearRegBody          = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"LINEARREG - Linear Regression","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","linearreg":"178.65711"},{"datetime":"2023-08-23","linearreg":"179.14533"},{"datetime":"2023-08-22","linearreg":"177.40844"},{"datetime":"2023-08-21","linearreg":""}],"status":"ok"}`)
+	linearRegAngleBody     = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"LINEARREGANGLE - Linear Regression Angle","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","linearregangle":"-6.17203"},{"datetime":"2023-08-23","linearregangle":"-7.82049"},{"datetime":"2023-08-22","linearregangle":"-19.10532"},{"datetime":"2023-08-21","linearregangle":""}],"status":"ok"}`)
+	linearRegInterceptBody = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"LINEARREGINTERCEPT - Linear Regression Intercept","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","linearregintercept":"179.52267"},{"datetime":"2023-08-23","linearregintercept":"180.24422"},{"datetime":"2023-08-22","linearregintercept":"180.18133"},{"datetime":"2023-08-21","linearregintercept":""}],"status":"ok"}`)
+	linearRegSlopeBody     = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"LINEARREGSLOPE - Linear Regression Slope","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","linearregslope":"-0.10819"},{"datetime":"2023-08-23","linearregslope":"-0.13736"},{"datetime":"2023-08-22","linearregslope":"-0.34661"},{"datetime":"2023-08-21","linearregslope":""}],"status":"ok"}`)
+	tsfBody                = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"TSF - Time Series Forecast","series_type":"close","time_period":9}},"values":[{"datetime":"2023-08-24","tsf":"178.54892"},{"datetime":"2023-08-23","tsf":"179.00797"},{"datetime":"2023-08-22","tsf":"177.06183"},{"datetime":"2023-08-21","tsf":""}],"status":"ok"}`)
+)
+
+func TestIntegrationIchimoku(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	_, err := client.Ichimoku("AAPL", model.OneDay, IchimokuOptions{})
+	if err != nil {
+		t.Log("Failed to make Ichimoku request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitIchimoku(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  IchimokuOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator IchimokuIndicator
+		values    int
+		first     IchimokuValue
+		last      IchimokuValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-09-29","tenkan_sen":"abc","kijun_sen":"1.5","senkou_span_a":"1.5","senkou_span_b":"1.5","chikou_span":"1.5"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value tenkan_sen into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return ichimokuBody, nil
+				},
+				opts: IchimokuOptions{BaseLinePeriod: 26, IncludeAheadSpanPeriod: true},
+			},
+			want{
+				params:    url.Values{"base_line_period": {"26"}, "include_ahead_span_period": {"true"}},
+				indicator: IchimokuIndicator{Name: "ICHIMOKU - Ichimoku Kink\u014d Hy\u014d", ConversionLinePeriod: 9, BaseLinePeriod: 26, LeadingSpanBPeriod: 52, LaggingSpanPeriod: 26, IncludeAheadSpanPeriod: true},
+				values:    2,
+				first:     IchimokuValue{Datetime: inNewYork(2023, time.September, 29), SenkouSpanA: ptr(186.5025), SenkouSpanB: ptr(183.645)},
+				last:      IchimokuValue{Datetime: inNewYork(2023, time.August, 24), TenkanSen: ptr(178.755), KijunSen: ptr(184.03), SenkouSpanA: ptr(189.265), SenkouSpanB: ptr(184.045), ChikouSpan: ptr(176.38)},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.Ichimoku("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/ichimoku", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+					assert.Equal(t, tt.want.last, response.Values[tt.want.values-1])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitPivotPointsHL(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  OverlayOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator OverlayIndicator
+		values    int
+		first     PivotPointsHLValue
+		last      PivotPointsHLValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","pivot_point_h":"abc","pivot_point_l":"1.5"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value pivot_point_h into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return pivotBody, nil
+				},
+				opts: OverlayOptions{TimePeriod: 10},
+			},
+			want{
+				params:    url.Values{"time_period": {"10"}},
+				indicator: OverlayIndicator{Name: "PIVOT_POINTS_HL - Pivot Points (High/Low)", TimePeriod: 10},
+				values:    2,
+				first:     PivotPointsHLValue{Datetime: inNewYork(2023, time.August, 24), PivotPointH: ptr(0), PivotPointL: ptr(1)},
+				last:      PivotPointsHLValue{Datetime: inNewYork(2023, time.August, 23)},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.PivotPointsHL("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/pivot_points_hl", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+					assert.Equal(t, tt.want.last, response.Values[tt.want.values-1])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitMidPoint(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  OverlayOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator OverlayIndicator
+		values    int
+		first     MidPointValue
+		last      MidPointValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","midpoint":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value midpoint into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return midPointBody, nil
+				},
+			},
+			want{
+				indicator: OverlayIndicator{Name: "MIDPOINT - MidPoint over period", SeriesType: "close", TimePeriod: 9},
+				values:    4,
+				first:     MidPointValue{Datetime: inNewYork(2023, time.August, 24), MidPoint: ptr(178.755)},
+				last:      MidPointValue{Datetime: inNewYork(2023, time.August, 21)},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.MidPoint("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/midpoint", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+					assert.Equal(t, tt.want.last, response.Values[tt.want.values-1])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitMidPrice(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  OverlayOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator OverlayIndicator
+		values    int
+		first     MidPriceValue
+		last      MidPriceValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","midprice":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value midprice into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return midPriceBody, nil
+				},
+			},
+			want{
+				indicator: OverlayIndicator{Name: "MIDPRICE - Midpoint Price over period", TimePeriod: 9},
+				values:    4,
+				first:     MidPriceValue{Datetime: inNewYork(2023, time.August, 24), MidPrice: ptr(178.755)},
+				last:      MidPriceValue{Datetime: inNewYork(2023, time.August, 21)},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.MidPrice("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/midprice", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+					assert.Equal(t, tt.want.last, response.Values[tt.want.values-1])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitHTTrendline(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  HTTrendlineOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator HTTrendlineIndicator
+		values    int
+		first     HTTrendlineValue
+		last      HTTrendlineValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","ht_trendline":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value ht_trendline into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return htTrendlineBody, nil
+				},
+				opts: HTTrendlineOptions{IndicatorOptions{SeriesType: SeriesTypeHigh}},
+			},
+			want{
+				params:    url.Values{"series_type": {"high"}},
+				indicator: HTTrendlineIndicator{Name: "HT_TRENDLINE - Hilbert Transform Instantaneous Trendline", SeriesType: "high"},
+				values:    4,
+				first:     HTTrendlineValue{Datetime: inNewYork(2023, time.August, 24), HTTrendline: ptr(180.28213)},
+				last:      HTTrendlineValue{Datetime: inNewYork(2023, time.August, 21)},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.HTTrendline("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/ht_trendline", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+					assert.Equal(t, tt.want.last, response.Values[tt.want.values-1])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitLinearReg(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  OverlayOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator OverlayIndicator
+		values    int
+		first     LinearRegValue
+		last      LinearRegValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","linearreg":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value linearreg into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return linearRegBody, nil
+				},
+			},
+			want{
+				indicator: OverlayIndicator{Name: "LINEARREG - Linear Regression", SeriesType: "close", TimePeriod: 9},
+				values:    4,
+				first:     LinearRegValue{Datetime: inNewYork(2023, time.August, 24), LinearReg: ptr(178.65711)},
+				last:      LinearRegValue{Datetime: inNewYork(2023, time.August, 21)},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.LinearReg("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/linearreg", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+					assert.Equal(t, tt.want.last, response.Values[tt.want.values-1])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitLinearRegAngle(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  OverlayOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator OverlayIndicator
+		values    int
+		first     LinearRegAngleValue
+		last      LinearRegAngleValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","linearregangle":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value linearregangle into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return linearRegAngleBody, nil
+				},
+			},
+			want{
+				indicator: OverlayIndicator{Name: "LINEARREGANGLE - Linear Regression Angle", SeriesType: "close", TimePeriod: 9},
+				values:    4,
+				first:     LinearRegAngleValue{Datetime: inNewYork(2023, time.August, 24), LinearRegAngle: ptr(-6.17203)},
+				last:      LinearRegAngleValue{Datetime: inNewYork(2023, time.August, 21)},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.LinearRegAngle("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/linearregangle", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+					assert.Equal(t, tt.want.last, response.Values[tt.want.values-1])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitLinearRegIntercept(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  OverlayOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator OverlayIndicator
+		values    int
+		first     LinearRegInterceptValue
+		last      LinearRegInterceptValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","linearregintercept":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value linearregintercept into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return linearRegInterceptBody, nil
+				},
+			},
+			want{
+				indicator: OverlayIndicator{Name: "LINEARREGINTERCEPT - Linear Regression Intercept", SeriesType: "close", TimePeriod: 9},
+				values:    4,
+				first:     LinearRegInterceptValue{Datetime: inNewYork(2023, time.August, 24), LinearRegIntercept: ptr(179.52267)},
+				last:      LinearRegInterceptValue{Datetime: inNewYork(2023, time.August, 21)},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.LinearRegIntercept("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/linearregintercept", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+					assert.Equal(t, tt.want.last, response.Values[tt.want.values-1])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitLinearRegSlope(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  OverlayOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator OverlayIndicator
+		values    int
+		first     LinearRegSlopeValue
+		last      LinearRegSlopeValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","linearregslope":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value linearregslope into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return linearRegSlopeBody, nil
+				},
+			},
+			want{
+				indicator: OverlayIndicator{Name: "LINEARREGSLOPE - Linear Regression Slope", SeriesType: "close", TimePeriod: 9},
+				values:    4,
+				first:     LinearRegSlopeValue{Datetime: inNewYork(2023, time.August, 24), LinearRegSlope: ptr(-0.10819)},
+				last:      LinearRegSlopeValue{Datetime: inNewYork(2023, time.August, 21)},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.LinearRegSlope("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/linearregslope", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+					assert.Equal(t, tt.want.last, response.Values[tt.want.values-1])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitTSF(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  OverlayOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator OverlayIndicator
+		values    int
+		first     TSFValue
+		last      TSFValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","tsf":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value tsf into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return tsfBody, nil
+				},
+			},
+			want{
+				indicator: OverlayIndicator{Name: "TSF - Time Series Forecast", SeriesType: "close", TimePeriod: 9},
+				values:    4,
+				first:     TSFValue{Datetime: inNewYork(2023, time.August, 24), Tsf: ptr(178.54892)},
+				last:      TSFValue{Datetime: inNewYork(2023, time.August, 21)},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.TSF("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/tsf", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+					assert.Equal(t, tt.want.last, response.Values[tt.want.values-1])
+				}
+			}
+		})
+	}
+}
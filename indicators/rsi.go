@@ -1,12 +1,13 @@
 package indicators
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/url"
 	"strconv"
 	"time"
 
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
 	"github.com/DefinitelyNotAGoat/twelvedata/model"
 	"github.com/pkg/errors"
 )
@@ -52,6 +53,10 @@ func (r *RSIValue) UnmarshalJSON(v []byte) error {
 	return nil
 }
 
+func (r *RSIValue) inLocation(loc *time.Location) {
+	r.Datetime = model.InLocation(r.Datetime, loc)
+}
+
 // RSIOptions - options for calling the twelvedata rsi endpoint: https://twelvedata.com/docs#rsi
 type RSIOptions struct {
 	IndicatorOptions
@@ -68,27 +73,11 @@ func (r RSIOptions) params(u *url.URL, urlValues url.Values) {
 	u.RawQuery = urlValues.Encode()
 }
 
-func rsi[Response IndicatorResponse[RSIValue, RSIIndicator]](symbol string, interval model.Interval, apiKey string, getFn getFn, opts RSIOptions) (Response, error) {
-	u, err := url.Parse(fmt.Sprintf("%s/rsi", baseURI))
-	if err != nil {
-		return Response{}, errors.Wrapf(err, "failed to parse base URL '%s'", baseURI)
-	}
-
-	opts.params(u, url.Values{
-		"symbol":   {symbol},
-		"interval": {string(interval)},
-		"apikey":   {apiKey},
-	})
-
-	body, err := getFn(u)
-	if err != nil {
-		return Response{}, err
-	}
-
-	var response Response
-	if err := json.Unmarshal(body, &response); err != nil {
-		return Response{}, err
-	}
+func rsi(ctx context.Context, c *client, symbol string, interval model.Interval, opts RSIOptions) (IndicatorResponse[RSIValue, RSIIndicator], error) {
+	return indicator[RSIValue, RSIIndicator](ctx, c, "rsi", symbol, interval, opts)
+}
 
-	return response, nil
+// RSIRequest - builds a RSI request to queue on a batch.Batch
+func RSIRequest(symbol string, interval model.Interval, opts RSIOptions) batch.Request[IndicatorResponse[RSIValue, RSIIndicator]] {
+	return request[RSIValue, RSIIndicator]("rsi", symbol, interval, opts)
 }
@@ -1,6 +1,7 @@
 package indicators
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/url"
@@ -50,7 +51,7 @@ func TestUnitRSI(t *testing.T) {
 		{
 			"handles failure to get",
 			input{
-				getFn: func(u *url.URL) ([]byte, error) {
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
 					return nil, errors.New("failed to get")
 				},
 			},
@@ -62,7 +63,7 @@ func TestUnitRSI(t *testing.T) {
 		{
 			"is successful",
 			input{
-				getFn: func(u *url.URL) ([]byte, error) {
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
 					return rsiBody, nil
 				},
 			},
@@ -1,12 +1,13 @@
 package indicators
 
 import (
+	"context"
 	"encoding/json"
-	"fmt"
 	"net/url"
 	"strconv"
 	"time"
 
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
 	"github.com/DefinitelyNotAGoat/twelvedata/model"
 	"github.com/pkg/errors"
 )
@@ -63,6 +64,10 @@ func (s *StochasticValue) UnmarshalJSON(v []byte) error {
 	return nil
 }
 
+func (s *StochasticValue) inLocation(loc *time.Location) {
+	s.Datetime = model.InLocation(s.Datetime, loc)
+}
+
 // StochasticOptions - options for calling the twelvedata stoch endpoint: https://twelvedata.com/docs#stoch
 type StochasticOptions struct {
 	IndicatorOptions
@@ -84,7 +89,7 @@ func (s StochasticOptions) params(u *url.URL, urlValues url.Values) {
 		urlValues.Add("slow_d_period", strconv.Itoa(s.SlowDPeriod))
 	}
 
-	if s.SlowDMAType == "" {
+	if s.SlowDMAType != "" {
 		urlValues.Add("slow_dma_type", s.SlowDMAType)
 	}
 
@@ -92,34 +97,18 @@ func (s StochasticOptions) params(u *url.URL, urlValues url.Values) {
 		urlValues.Add("slow_k_period", strconv.Itoa(s.SlowKPeriod))
 	}
 
-	if s.SlowKMAType == "" {
+	if s.SlowKMAType != "" {
 		urlValues.Add("slow_kma_type", s.SlowKMAType)
 	}
 
 	u.RawQuery = urlValues.Encode()
 }
 
-func stochastic[Response IndicatorResponse[StochasticValue, StochasticIndicator]](symbol string, interval model.Interval, apiKey string, getFn getFn, opts StochasticOptions) (Response, error) {
-	u, err := url.Parse(fmt.Sprintf("%s/stoch", baseURI))
-	if err != nil {
-		return Response{}, errors.Wrapf(err, "failed to parse base URL '%s'", baseURI)
-	}
-
-	opts.params(u, url.Values{
-		"symbol":   {symbol},
-		"interval": {string(interval)},
-		"apikey":   {apiKey},
-	})
-
-	body, err := getFn(u)
-	if err != nil {
-		return Response{}, err
-	}
-
-	var response Response
-	if err := json.Unmarshal(body, &response); err != nil {
-		return Response{}, err
-	}
+func stochastic(ctx context.Context, c *client, symbol string, interval model.Interval, opts StochasticOptions) (IndicatorResponse[StochasticValue, StochasticIndicator], error) {
+	return indicator[StochasticValue, StochasticIndicator](ctx, c, "stoch", symbol, interval, opts)
+}
 
-	return response, nil
+// StochasticRequest - builds a Stochastic request to queue on a batch.Batch
+func StochasticRequest(symbol string, interval model.Interval, opts StochasticOptions) batch.Request[IndicatorResponse[StochasticValue, StochasticIndicator]] {
+	return request[StochasticValue, StochasticIndicator]("stoch", symbol, interval, opts)
 }
@@ -1,6 +1,7 @@
 package indicators
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/url"
@@ -50,7 +51,7 @@ func TestUnitStochastic(t *testing.T) {
 		{
 			"handles failure to get",
 			input{
-				getFn: func(u *url.URL) ([]byte, error) {
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
 					return nil, errors.New("failed to get")
 				},
 			},
@@ -62,7 +63,7 @@ func TestUnitStochastic(t *testing.T) {
 		{
 			"is successful",
 			input{
-				getFn: func(u *url.URL) ([]byte, error) {
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
 					return stochBody, nil
 				},
 			},
@@ -90,3 +91,30 @@ func TestUnitStochastic(t *testing.T) {
 		})
 	}
 }
+
+func TestUnitStochasticOptions(t *testing.T) {
+	cases := []struct {
+		name  string
+		input StochasticOptions
+		want  url.Values
+	}{
+		{
+			"leaves out the moving average types that are not set",
+			StochasticOptions{FastKPeriod: 14},
+			url.Values{"fast_k_period": {"14"}},
+		},
+		{
+			"adds the moving average types that are set",
+			StochasticOptions{SlowDMAType: "EMA", SlowKMAType: "WMA"},
+			url.Values{"slow_dma_type": {"EMA"}, "slow_kma_type": {"WMA"}},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			u := &url.URL{}
+			tt.input.params(u, url.Values{})
+			assert.Equal(t, tt.want, u.Query())
+		})
+	}
+}
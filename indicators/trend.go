@@ -0,0 +1,558 @@
+package indicators
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+)
+
+// DirectionalIndicator - the Indicator value for IndicatorMeta shared by the directional movement and aroon indicators
+type DirectionalIndicator struct {
+	Name       string `json:"name"`
+	TimePeriod int    `json:"time_period"`
+}
+
+// SuperTrendIndicator - the Indicator value for IndicatorMeta specific for SuperTrend
+type SuperTrendIndicator struct {
+	Name       string  `json:"name"`
+	Period     int     `json:"period"`
+	Multiplier float64 `json:"multiplier"`
+}
+
+// SARIndicator - the Indicator value for IndicatorMeta specific for SAR
+type SARIndicator struct {
+	Name         string  `json:"name"`
+	Acceleration float64 `json:"acceleration"`
+	Maximum      float64 `json:"maximum"`
+}
+
+// DirectionalOptions - options for calling the twelvedata directional movement and aroon endpoints, e.g.
+// https://twelvedata.com/docs#adx
+type DirectionalOptions struct {
+	IndicatorOptions
+	TimePeriod int
+}
+
+func (d DirectionalOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = d.IndicatorOptions.params(u, urlValues)
+
+	if d.TimePeriod > 0 {
+		urlValues.Add("time_period", strconv.Itoa(d.TimePeriod))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// SuperTrendOptions - options for calling the twelvedata supertrend endpoint: https://twelvedata.com/docs#supertrend
+type SuperTrendOptions struct {
+	IndicatorOptions
+	Period     int
+	Multiplier float64
+}
+
+func (s SuperTrendOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = s.IndicatorOptions.params(u, urlValues)
+
+	if s.Period > 0 {
+		urlValues.Add("period", strconv.Itoa(s.Period))
+	}
+
+	if s.Multiplier > 0 {
+		urlValues.Add("multiplier", strconv.FormatFloat(s.Multiplier, 'f', -1, 64))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// SAROptions - options for calling the twelvedata sar endpoint: https://twelvedata.com/docs#sar
+type SAROptions struct {
+	IndicatorOptions
+	Acceleration float64
+	Maximum      float64
+}
+
+func (s SAROptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = s.IndicatorOptions.params(u, urlValues)
+
+	if s.Acceleration > 0 {
+		urlValues.Add("acceleration", strconv.FormatFloat(s.Acceleration, 'f', -1, 64))
+	}
+
+	if s.Maximum > 0 {
+		urlValues.Add("maximum", strconv.FormatFloat(s.Maximum, 'f', -1, 64))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// ADXValue - the Indicator value for IndicatorResponse specific for ADX
+type ADXValue struct {
+	Datetime time.Time `json:"datetime"`
+	Adx      float64   `json:"adx"`
+}
+
+// UnmarshalJSON - unmarshal's ADXValue to a more consumable type
+func (a *ADXValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Adx      string `json:"adx"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	adx, err := parseFloat(value.Adx, "adx")
+	if err != nil {
+		return err
+	}
+
+	a.Datetime = dateTime
+	a.Adx = adx
+
+	return nil
+}
+
+func (a *ADXValue) inLocation(loc *time.Location) {
+	a.Datetime = model.InLocation(a.Datetime, loc)
+}
+
+// ADXRequest - builds a ADX request to queue on a batch.Batch
+func ADXRequest(symbol string, interval model.Interval, opts DirectionalOptions) batch.Request[IndicatorResponse[ADXValue, DirectionalIndicator]] {
+	return request[ADXValue, DirectionalIndicator]("adx", symbol, interval, opts)
+}
+
+// ADXRValue - the Indicator value for IndicatorResponse specific for ADXR
+type ADXRValue struct {
+	Datetime time.Time `json:"datetime"`
+	Adxr     float64   `json:"adxr"`
+}
+
+// UnmarshalJSON - unmarshal's ADXRValue to a more consumable type
+func (a *ADXRValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Adxr     string `json:"adxr"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	adxr, err := parseFloat(value.Adxr, "adxr")
+	if err != nil {
+		return err
+	}
+
+	a.Datetime = dateTime
+	a.Adxr = adxr
+
+	return nil
+}
+
+func (a *ADXRValue) inLocation(loc *time.Location) {
+	a.Datetime = model.InLocation(a.Datetime, loc)
+}
+
+// ADXRRequest - builds a ADXR request to queue on a batch.Batch
+func ADXRRequest(symbol string, interval model.Interval, opts DirectionalOptions) batch.Request[IndicatorResponse[ADXRValue, DirectionalIndicator]] {
+	return request[ADXRValue, DirectionalIndicator]("adxr", symbol, interval, opts)
+}
+
+// DXValue - the Indicator value for IndicatorResponse specific for DX
+type DXValue struct {
+	Datetime time.Time `json:"datetime"`
+	Dx       float64   `json:"dx"`
+}
+
+// UnmarshalJSON - unmarshal's DXValue to a more consumable type
+func (d *DXValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Dx       string `json:"dx"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	dx, err := parseFloat(value.Dx, "dx")
+	if err != nil {
+		return err
+	}
+
+	d.Datetime = dateTime
+	d.Dx = dx
+
+	return nil
+}
+
+func (d *DXValue) inLocation(loc *time.Location) {
+	d.Datetime = model.InLocation(d.Datetime, loc)
+}
+
+// DXRequest - builds a DX request to queue on a batch.Batch
+func DXRequest(symbol string, interval model.Interval, opts DirectionalOptions) batch.Request[IndicatorResponse[DXValue, DirectionalIndicator]] {
+	return request[DXValue, DirectionalIndicator]("dx", symbol, interval, opts)
+}
+
+// PlusDIValue - the Indicator value for IndicatorResponse specific for PlusDI
+type PlusDIValue struct {
+	Datetime time.Time `json:"datetime"`
+	PlusDi   float64   `json:"plus_di"`
+}
+
+// UnmarshalJSON - unmarshal's PlusDIValue to a more consumable type
+func (p *PlusDIValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		PlusDi   string `json:"plus_di"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	plusDi, err := parseFloat(value.PlusDi, "plus_di")
+	if err != nil {
+		return err
+	}
+
+	p.Datetime = dateTime
+	p.PlusDi = plusDi
+
+	return nil
+}
+
+func (p *PlusDIValue) inLocation(loc *time.Location) {
+	p.Datetime = model.InLocation(p.Datetime, loc)
+}
+
+// PlusDIRequest - builds a PlusDI request to queue on a batch.Batch
+func PlusDIRequest(symbol string, interval model.Interval, opts DirectionalOptions) batch.Request[IndicatorResponse[PlusDIValue, DirectionalIndicator]] {
+	return request[PlusDIValue, DirectionalIndicator]("plus_di", symbol, interval, opts)
+}
+
+// MinusDIValue - the Indicator value for IndicatorResponse specific for MinusDI
+type MinusDIValue struct {
+	Datetime time.Time `json:"datetime"`
+	MinusDi  float64   `json:"minus_di"`
+}
+
+// UnmarshalJSON - unmarshal's MinusDIValue to a more consumable type
+func (m *MinusDIValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		MinusDi  string `json:"minus_di"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	minusDi, err := parseFloat(value.MinusDi, "minus_di")
+	if err != nil {
+		return err
+	}
+
+	m.Datetime = dateTime
+	m.MinusDi = minusDi
+
+	return nil
+}
+
+func (m *MinusDIValue) inLocation(loc *time.Location) {
+	m.Datetime = model.InLocation(m.Datetime, loc)
+}
+
+// MinusDIRequest - builds a MinusDI request to queue on a batch.Batch
+func MinusDIRequest(symbol string, interval model.Interval, opts DirectionalOptions) batch.Request[IndicatorResponse[MinusDIValue, DirectionalIndicator]] {
+	return request[MinusDIValue, DirectionalIndicator]("minus_di", symbol, interval, opts)
+}
+
+// AroonValue - the Indicator value for IndicatorResponse specific for Aroon
+type AroonValue struct {
+	Datetime  time.Time `json:"datetime"`
+	AroonDown float64   `json:"aroon_down"`
+	AroonUp   float64   `json:"aroon_up"`
+}
+
+// UnmarshalJSON - unmarshal's AroonValue to a more consumable type
+func (a *AroonValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime  string `json:"datetime"`
+		AroonDown string `json:"aroon_down"`
+		AroonUp   string `json:"aroon_up"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	aroonDown, err := parseFloat(value.AroonDown, "aroon_down")
+	if err != nil {
+		return err
+	}
+
+	aroonUp, err := parseFloat(value.AroonUp, "aroon_up")
+	if err != nil {
+		return err
+	}
+
+	a.Datetime = dateTime
+	a.AroonDown = aroonDown
+	a.AroonUp = aroonUp
+
+	return nil
+}
+
+func (a *AroonValue) inLocation(loc *time.Location) {
+	a.Datetime = model.InLocation(a.Datetime, loc)
+}
+
+// AroonRequest - builds a Aroon request to queue on a batch.Batch
+func AroonRequest(symbol string, interval model.Interval, opts DirectionalOptions) batch.Request[IndicatorResponse[AroonValue, DirectionalIndicator]] {
+	return request[AroonValue, DirectionalIndicator]("aroon", symbol, interval, opts)
+}
+
+// AroonOscValue - the Indicator value for IndicatorResponse specific for AroonOsc
+type AroonOscValue struct {
+	Datetime time.Time `json:"datetime"`
+	AroonOsc float64   `json:"aroonosc"`
+}
+
+// UnmarshalJSON - unmarshal's AroonOscValue to a more consumable type
+func (a *AroonOscValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		AroonOsc string `json:"aroonosc"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	aroonOsc, err := parseFloat(value.AroonOsc, "aroonosc")
+	if err != nil {
+		return err
+	}
+
+	a.Datetime = dateTime
+	a.AroonOsc = aroonOsc
+
+	return nil
+}
+
+func (a *AroonOscValue) inLocation(loc *time.Location) {
+	a.Datetime = model.InLocation(a.Datetime, loc)
+}
+
+// AroonOscRequest - builds a AroonOsc request to queue on a batch.Batch
+func AroonOscRequest(symbol string, interval model.Interval, opts DirectionalOptions) batch.Request[IndicatorResponse[AroonOscValue, DirectionalIndicator]] {
+	return request[AroonOscValue, DirectionalIndicator]("aroonosc", symbol, interval, opts)
+}
+
+// SuperTrendValue - the Indicator value for IndicatorResponse specific for SuperTrend
+type SuperTrendValue struct {
+	Datetime   time.Time `json:"datetime"`
+	SuperTrend float64   `json:"supertrend"`
+}
+
+// UnmarshalJSON - unmarshal's SuperTrendValue to a more consumable type
+func (s *SuperTrendValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime   string `json:"datetime"`
+		SuperTrend string `json:"supertrend"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	superTrend, err := parseFloat(value.SuperTrend, "supertrend")
+	if err != nil {
+		return err
+	}
+
+	s.Datetime = dateTime
+	s.SuperTrend = superTrend
+
+	return nil
+}
+
+func (s *SuperTrendValue) inLocation(loc *time.Location) {
+	s.Datetime = model.InLocation(s.Datetime, loc)
+}
+
+// SuperTrendRequest - builds a SuperTrend request to queue on a batch.Batch
+func SuperTrendRequest(symbol string, interval model.Interval, opts SuperTrendOptions) batch.Request[IndicatorResponse[SuperTrendValue, SuperTrendIndicator]] {
+	return request[SuperTrendValue, SuperTrendIndicator]("supertrend", symbol, interval, opts)
+}
+
+// SARValue - the Indicator value for IndicatorResponse specific for SAR
+type SARValue struct {
+	Datetime time.Time `json:"datetime"`
+	Sar      float64   `json:"sar"`
+}
+
+// UnmarshalJSON - unmarshal's SARValue to a more consumable type
+func (s *SARValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Sar      string `json:"sar"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	sar, err := parseFloat(value.Sar, "sar")
+	if err != nil {
+		return err
+	}
+
+	s.Datetime = dateTime
+	s.Sar = sar
+
+	return nil
+}
+
+func (s *SARValue) inLocation(loc *time.Location) {
+	s.Datetime = model.InLocation(s.Datetime, loc)
+}
+
+// SARRequest - builds a SAR request to queue on a batch.Batch
+func SARRequest(symbol string, interval model.Interval, opts SAROptions) batch.Request[IndicatorResponse[SARValue, SARIndicator]] {
+	return request[SARValue, SARIndicator]("sar", symbol, interval, opts)
+}
+
+// ADX - gets the Average Directional Index: https://twelvedata.com/docs#adx
+func (c *client) ADX(symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[ADXValue, DirectionalIndicator], error) {
+	return c.ADXWithContext(context.Background(), symbol, interval, opts)
+}
+
+// ADXWithContext - same as ADX, but bound to ctx
+func (c *client) ADXWithContext(ctx context.Context, symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[ADXValue, DirectionalIndicator], error) {
+	return indicator[ADXValue, DirectionalIndicator](ctx, c, "adx", symbol, interval, opts)
+}
+
+// ADXR - gets the Average Directional Movement Index Rating: https://twelvedata.com/docs#adxr
+func (c *client) ADXR(symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[ADXRValue, DirectionalIndicator], error) {
+	return c.ADXRWithContext(context.Background(), symbol, interval, opts)
+}
+
+// ADXRWithContext - same as ADXR, but bound to ctx
+func (c *client) ADXRWithContext(ctx context.Context, symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[ADXRValue, DirectionalIndicator], error) {
+	return indicator[ADXRValue, DirectionalIndicator](ctx, c, "adxr", symbol, interval, opts)
+}
+
+// DX - gets the Directional Movement Index: https://twelvedata.com/docs#dx
+func (c *client) DX(symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[DXValue, DirectionalIndicator], error) {
+	return c.DXWithContext(context.Background(), symbol, interval, opts)
+}
+
+// DXWithContext - same as DX, but bound to ctx
+func (c *client) DXWithContext(ctx context.Context, symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[DXValue, DirectionalIndicator], error) {
+	return indicator[DXValue, DirectionalIndicator](ctx, c, "dx", symbol, interval, opts)
+}
+
+// PlusDI - gets the Plus Directional Indicator: https://twelvedata.com/docs#plus_di
+func (c *client) PlusDI(symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[PlusDIValue, DirectionalIndicator], error) {
+	return c.PlusDIWithContext(context.Background(), symbol, interval, opts)
+}
+
+// PlusDIWithContext - same as PlusDI, but bound to ctx
+func (c *client) PlusDIWithContext(ctx context.Context, symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[PlusDIValue, DirectionalIndicator], error) {
+	return indicator[PlusDIValue, DirectionalIndicator](ctx, c, "plus_di", symbol, interval, opts)
+}
+
+// MinusDI - gets the Minus Directional Indicator: https://twelvedata.com/docs#minus_di
+func (c *client) MinusDI(symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[MinusDIValue, DirectionalIndicator], error) {
+	return c.MinusDIWithContext(context.Background(), symbol, interval, opts)
+}
+
+// MinusDIWithContext - same as MinusDI, but bound to ctx
+func (c *client) MinusDIWithContext(ctx context.Context, symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[MinusDIValue, DirectionalIndicator], error) {
+	return indicator[MinusDIValue, DirectionalIndicator](ctx, c, "minus_di", symbol, interval, opts)
+}
+
+// Aroon - gets the Aroon Indicator: https://twelvedata.com/docs#aroon
+func (c *client) Aroon(symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[AroonValue, DirectionalIndicator], error) {
+	return c.AroonWithContext(context.Background(), symbol, interval, opts)
+}
+
+// AroonWithContext - same as Aroon, but bound to ctx
+func (c *client) AroonWithContext(ctx context.Context, symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[AroonValue, DirectionalIndicator], error) {
+	return indicator[AroonValue, DirectionalIndicator](ctx, c, "aroon", symbol, interval, opts)
+}
+
+// AroonOsc - gets the Aroon Oscillator: https://twelvedata.com/docs#aroonosc
+func (c *client) AroonOsc(symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[AroonOscValue, DirectionalIndicator], error) {
+	return c.AroonOscWithContext(context.Background(), symbol, interval, opts)
+}
+
+// AroonOscWithContext - same as AroonOsc, but bound to ctx
+func (c *client) AroonOscWithContext(ctx context.Context, symbol string, interval model.Interval, opts DirectionalOptions) (IndicatorResponse[AroonOscValue, DirectionalIndicator], error) {
+	return indicator[AroonOscValue, DirectionalIndicator](ctx, c, "aroonosc", symbol, interval, opts)
+}
+
+// SuperTrend - gets the SuperTrend: https://twelvedata.com/docs#supertrend
+func (c *client) SuperTrend(symbol string, interval model.Interval, opts SuperTrendOptions) (IndicatorResponse[SuperTrendValue, SuperTrendIndicator], error) {
+	return c.SuperTrendWithContext(context.Background(), symbol, interval, opts)
+}
+
+// SuperTrendWithContext - same as SuperTrend, but bound to ctx
+func (c *client) SuperTrendWithContext(ctx context.Context, symbol string, interval model.Interval, opts SuperTrendOptions) (IndicatorResponse[SuperTrendValue, SuperTrendIndicator], error) {
+	return indicator[SuperTrendValue, SuperTrendIndicator](ctx, c, "supertrend", symbol, interval, opts)
+}
+
+// SAR - gets the Parabolic SAR: https://twelvedata.com/docs#sar
+func (c *client) SAR(symbol string, interval model.Interval, opts SAROptions) (IndicatorResponse[SARValue, SARIndicator], error) {
+	return c.SARWithContext(context.Background(), symbol, interval, opts)
+}
+
+// SARWithContext - same as SAR, but bound to ctx
+func (c *client) SARWithContext(ctx context.Context, symbol string, interval model.Interval, opts SAROptions) (IndicatorResponse[SARValue, SARIndicator], error) {
+	return indicator[SARValue, SARIndicator](ctx, c, "sar", symbol, interval, opts)
+}
@@ -0,0 +1,873 @@
+package indicators
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	adxBody        = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"ADX - Average Directional Index","time_period":14}},"values":[{"datetime":"2023-08-24","adx":"31.20452"}],"status":"ok"}`)
+	aroonBody      = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"AROON - Aroon Indicator","time_period":14}},"values":[{"datetime":"2023-08-24","aroon_down":"85.71429","aroon_up":"7.14286"}],"status":"ok"}`)
+	superTrendBody = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"SuperTrend","period":10,"multiplier":3}},"values":[{"datetime":"2023-08-24","supertrend":"184.38512"}],"status":"ok"}`)
+	sarBody        = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"SAR - Parabolic SAR","acceleration":0.02,"maximum":0.2}},"values":[{"datetime":"2023-08-24","sar":"182.41860"}],"status":"ok"}`)
+	adxrBody       = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"ADXR - Average Directional Movement Index Rating","time_period":14}},"values":[{"datetime":"2023-08-24","adxr":"29.87314"},{"datetime":"2023-08-23","adxr":"29.52167"},{"datetime":"2023-08-22","adxr":"29.10652"}],"status":"ok"}`)
+	dxBody         = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"DX - Directional Movement Index","time_period":14}},"values":[{"datetime":"2023-08-24","dx":"38.64972"},{"datetime":"2023-08-23","dx":"30.15839"},{"datetime":"2023-08-22","dx":"41.39457"}],"status":"ok"}`)
+	plusDIBody     = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"PLUS_DI - Plus Directional Indicator","time_period":14}},"values":[{"datetime":"2023-08-24","plus_di":"14.27013"},{"datetime":"2023-08-23","plus_di":"16.02558"},{"datetime":"2023-08-22","plus_di":"13.46315"}],"status":"ok"}`)
+	minusDIBody    = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"MINUS_DI - Minus Directional Indicator","time_period":14}},"values":[{"datetime":"2023-08-24","minus_di":"32.24573"},{"datetime":"2023-08-23","minus_di":"29.84931"},{"datetime":"2023-08-22","minus_di":"32.44016"}],"status":"ok"}`)
+	aroonOscBody   = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"AROONOSC - Aroon Oscillator","time_period":14}},"values":[{"datetime":"2023-08-24","aroonosc":"-78.57143"},{"datetime":"2023-08-23","aroonosc":"-71.42857"},{"datetime":"2023-08-22","aroonosc":"-78.57143"}],"status":"ok"}`)
+)
+
+func TestIntegrationADX(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	_, err := client.ADX("AAPL", model.OneHour, DirectionalOptions{})
+	if err != nil {
+		t.Log("Failed to make ADX request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitADX(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  DirectionalOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator DirectionalIndicator
+		values    int
+		first     ADXValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","adx":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value adx into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return adxBody, nil
+				},
+				opts: DirectionalOptions{TimePeriod: 14},
+			},
+			want{
+				params:    url.Values{"time_period": {"14"}},
+				indicator: DirectionalIndicator{Name: "ADX - Average Directional Index", TimePeriod: 14},
+				values:    1,
+				first:     ADXValue{Datetime: inNewYork(2023, time.August, 24), Adx: 31.20452},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.ADX("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/adx", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitADXR(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  DirectionalOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator DirectionalIndicator
+		values    int
+		first     ADXRValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","adxr":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value adxr into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return adxrBody, nil
+				},
+			},
+			want{
+				indicator: DirectionalIndicator{Name: "ADXR - Average Directional Movement Index Rating", TimePeriod: 14},
+				values:    3,
+				first:     ADXRValue{Datetime: inNewYork(2023, time.August, 24), Adxr: 29.87314},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.ADXR("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/adxr", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitDX(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  DirectionalOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator DirectionalIndicator
+		values    int
+		first     DXValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","dx":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value dx into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return dxBody, nil
+				},
+			},
+			want{
+				indicator: DirectionalIndicator{Name: "DX - Directional Movement Index", TimePeriod: 14},
+				values:    3,
+				first:     DXValue{Datetime: inNewYork(2023, time.August, 24), Dx: 38.64972},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.DX("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/dx", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitPlusDI(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  DirectionalOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator DirectionalIndicator
+		values    int
+		first     PlusDIValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","plus_di":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value plus_di into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return plusDIBody, nil
+				},
+			},
+			want{
+				indicator: DirectionalIndicator{Name: "PLUS_DI - Plus Directional Indicator", TimePeriod: 14},
+				values:    3,
+				first:     PlusDIValue{Datetime: inNewYork(2023, time.August, 24), PlusDi: 14.27013},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.PlusDI("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/plus_di", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitMinusDI(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  DirectionalOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator DirectionalIndicator
+		values    int
+		first     MinusDIValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","minus_di":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value minus_di into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return minusDIBody, nil
+				},
+			},
+			want{
+				indicator: DirectionalIndicator{Name: "MINUS_DI - Minus Directional Indicator", TimePeriod: 14},
+				values:    3,
+				first:     MinusDIValue{Datetime: inNewYork(2023, time.August, 24), MinusDi: 32.24573},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.MinusDI("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/minus_di", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitAroon(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  DirectionalOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator DirectionalIndicator
+		values    int
+		first     AroonValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","aroon_down":"abc","aroon_up":"1.5"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value aroon_down into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return aroonBody, nil
+				},
+			},
+			want{
+				indicator: DirectionalIndicator{Name: "AROON - Aroon Indicator", TimePeriod: 14},
+				values:    1,
+				first:     AroonValue{Datetime: inNewYork(2023, time.August, 24), AroonDown: 85.71429, AroonUp: 7.14286},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.Aroon("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/aroon", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitAroonOsc(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  DirectionalOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator DirectionalIndicator
+		values    int
+		first     AroonOscValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","aroonosc":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value aroonosc into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return aroonOscBody, nil
+				},
+			},
+			want{
+				indicator: DirectionalIndicator{Name: "AROONOSC - Aroon Oscillator", TimePeriod: 14},
+				values:    3,
+				first:     AroonOscValue{Datetime: inNewYork(2023, time.August, 24), AroonOsc: -78.57143},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.AroonOsc("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/aroonosc", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitSuperTrend(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  SuperTrendOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator SuperTrendIndicator
+		values    int
+		first     SuperTrendValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","supertrend":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value supertrend into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return superTrendBody, nil
+				},
+				opts: SuperTrendOptions{Period: 10, Multiplier: 3},
+			},
+			want{
+				params:    url.Values{"period": {"10"}, "multiplier": {"3"}},
+				indicator: SuperTrendIndicator{Name: "SuperTrend", Period: 10, Multiplier: 3},
+				values:    1,
+				first:     SuperTrendValue{Datetime: inNewYork(2023, time.August, 24), SuperTrend: 184.38512},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.SuperTrend("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/supertrend", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitSAR(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  SAROptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator SARIndicator
+		values    int
+		first     SARValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","sar":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value sar into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return sarBody, nil
+				},
+				opts: SAROptions{Acceleration: 0.02, Maximum: 0.2},
+			},
+			want{
+				params:    url.Values{"acceleration": {"0.02"}, "maximum": {"0.2"}},
+				indicator: SARIndicator{Name: "SAR - Parabolic SAR", Acceleration: 0.02, Maximum: 0.2},
+				values:    1,
+				first:     SARValue{Datetime: inNewYork(2023, time.August, 24), Sar: 182.4186},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.SAR("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/sar", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,347 @@
+package indicators
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+)
+
+// BBANDSIndicator - the Indicator value for IndicatorMeta specific for BBANDS
+type BBANDSIndicator struct {
+	Name       string  `json:"name"`
+	SeriesType string  `json:"series_type"`
+	TimePeriod int     `json:"time_period"`
+	SD         float64 `json:"sd"`
+	MAType     MAType  `json:"ma_type"`
+}
+
+// ATRIndicator - the Indicator value for IndicatorMeta specific for ATR and NATR
+type ATRIndicator struct {
+	Name       string `json:"name"`
+	TimePeriod int    `json:"time_period"`
+}
+
+// KeltnerIndicator - the Indicator value for IndicatorMeta specific for Keltner
+type KeltnerIndicator struct {
+	Name          string  `json:"name"`
+	SeriesType    string  `json:"series_type"`
+	TimePeriod    int     `json:"time_period"`
+	ATRTimePeriod int     `json:"atr_time_period"`
+	Multiplier    float64 `json:"multiplier"`
+	MAType        MAType  `json:"ma_type"`
+}
+
+// BBANDSOptions - options for calling the twelvedata bbands endpoint: https://twelvedata.com/docs#bbands
+type BBANDSOptions struct {
+	IndicatorOptions
+	TimePeriod int
+	// SD - the number of standard deviations between the middle and the upper or lower band
+	SD     float64
+	MAType MAType
+}
+
+func (b BBANDSOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = b.IndicatorOptions.params(u, urlValues)
+
+	if b.TimePeriod > 0 {
+		urlValues.Add("time_period", strconv.Itoa(b.TimePeriod))
+	}
+
+	if b.SD > 0 {
+		urlValues.Add("sd", strconv.FormatFloat(b.SD, 'f', -1, 64))
+	}
+
+	if b.MAType != "" {
+		urlValues.Add("ma_type", string(b.MAType))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// ATROptions - options for calling the twelvedata atr and natr endpoints: https://twelvedata.com/docs#atr
+type ATROptions struct {
+	IndicatorOptions
+	TimePeriod int
+}
+
+func (a ATROptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = a.IndicatorOptions.params(u, urlValues)
+
+	if a.TimePeriod > 0 {
+		urlValues.Add("time_period", strconv.Itoa(a.TimePeriod))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// KeltnerOptions - options for calling the twelvedata keltner endpoint: https://twelvedata.com/docs#keltner
+type KeltnerOptions struct {
+	IndicatorOptions
+	TimePeriod    int
+	ATRTimePeriod int
+	Multiplier    float64
+	MAType        MAType
+}
+
+func (k KeltnerOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = k.IndicatorOptions.params(u, urlValues)
+
+	if k.TimePeriod > 0 {
+		urlValues.Add("time_period", strconv.Itoa(k.TimePeriod))
+	}
+
+	if k.ATRTimePeriod > 0 {
+		urlValues.Add("atr_time_period", strconv.Itoa(k.ATRTimePeriod))
+	}
+
+	if k.Multiplier > 0 {
+		urlValues.Add("multiplier", strconv.FormatFloat(k.Multiplier, 'f', -1, 64))
+	}
+
+	if k.MAType != "" {
+		urlValues.Add("ma_type", string(k.MAType))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// BBANDSValue - the Indicator value for IndicatorResponse specific for BBANDS
+type BBANDSValue struct {
+	Datetime   time.Time `json:"datetime"`
+	UpperBand  float64   `json:"upper_band"`
+	MiddleBand float64   `json:"middle_band"`
+	LowerBand  float64   `json:"lower_band"`
+}
+
+// UnmarshalJSON - unmarshal's BBANDSValue to a more consumable type
+func (b *BBANDSValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime   string `json:"datetime"`
+		UpperBand  string `json:"upper_band"`
+		MiddleBand string `json:"middle_band"`
+		LowerBand  string `json:"lower_band"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	upperBand, err := parseFloat(value.UpperBand, "upper_band")
+	if err != nil {
+		return err
+	}
+
+	middleBand, err := parseFloat(value.MiddleBand, "middle_band")
+	if err != nil {
+		return err
+	}
+
+	lowerBand, err := parseFloat(value.LowerBand, "lower_band")
+	if err != nil {
+		return err
+	}
+
+	b.Datetime = dateTime
+	b.UpperBand = upperBand
+	b.MiddleBand = middleBand
+	b.LowerBand = lowerBand
+
+	return nil
+}
+
+func (b *BBANDSValue) inLocation(loc *time.Location) {
+	b.Datetime = model.InLocation(b.Datetime, loc)
+}
+
+// BBANDSRequest - builds a BBANDS request to queue on a batch.Batch
+func BBANDSRequest(symbol string, interval model.Interval, opts BBANDSOptions) batch.Request[IndicatorResponse[BBANDSValue, BBANDSIndicator]] {
+	return request[BBANDSValue, BBANDSIndicator]("bbands", symbol, interval, opts)
+}
+
+// ATRValue - the Indicator value for IndicatorResponse specific for ATR
+type ATRValue struct {
+	Datetime time.Time `json:"datetime"`
+	Atr      float64   `json:"atr"`
+}
+
+// UnmarshalJSON - unmarshal's ATRValue to a more consumable type
+func (a *ATRValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Atr      string `json:"atr"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	atr, err := parseFloat(value.Atr, "atr")
+	if err != nil {
+		return err
+	}
+
+	a.Datetime = dateTime
+	a.Atr = atr
+
+	return nil
+}
+
+func (a *ATRValue) inLocation(loc *time.Location) {
+	a.Datetime = model.InLocation(a.Datetime, loc)
+}
+
+// ATRRequest - builds a ATR request to queue on a batch.Batch
+func ATRRequest(symbol string, interval model.Interval, opts ATROptions) batch.Request[IndicatorResponse[ATRValue, ATRIndicator]] {
+	return request[ATRValue, ATRIndicator]("atr", symbol, interval, opts)
+}
+
+// NATRValue - the Indicator value for IndicatorResponse specific for NATR
+type NATRValue struct {
+	Datetime time.Time `json:"datetime"`
+	Natr     float64   `json:"natr"`
+}
+
+// UnmarshalJSON - unmarshal's NATRValue to a more consumable type
+func (n *NATRValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Natr     string `json:"natr"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	natr, err := parseFloat(value.Natr, "natr")
+	if err != nil {
+		return err
+	}
+
+	n.Datetime = dateTime
+	n.Natr = natr
+
+	return nil
+}
+
+func (n *NATRValue) inLocation(loc *time.Location) {
+	n.Datetime = model.InLocation(n.Datetime, loc)
+}
+
+// NATRRequest - builds a NATR request to queue on a batch.Batch
+func NATRRequest(symbol string, interval model.Interval, opts ATROptions) batch.Request[IndicatorResponse[NATRValue, ATRIndicator]] {
+	return request[NATRValue, ATRIndicator]("natr", symbol, interval, opts)
+}
+
+// KeltnerValue - the Indicator value for IndicatorResponse specific for Keltner
+type KeltnerValue struct {
+	Datetime   time.Time `json:"datetime"`
+	UpperLine  float64   `json:"upper_line"`
+	MiddleLine float64   `json:"middle_line"`
+	LowerLine  float64   `json:"lower_line"`
+}
+
+// UnmarshalJSON - unmarshal's KeltnerValue to a more consumable type
+func (k *KeltnerValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime   string `json:"datetime"`
+		UpperLine  string `json:"upper_line"`
+		MiddleLine string `json:"middle_line"`
+		LowerLine  string `json:"lower_line"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	upperLine, err := parseFloat(value.UpperLine, "upper_line")
+	if err != nil {
+		return err
+	}
+
+	middleLine, err := parseFloat(value.MiddleLine, "middle_line")
+	if err != nil {
+		return err
+	}
+
+	lowerLine, err := parseFloat(value.LowerLine, "lower_line")
+	if err != nil {
+		return err
+	}
+
+	k.Datetime = dateTime
+	k.UpperLine = upperLine
+	k.MiddleLine = middleLine
+	k.LowerLine = lowerLine
+
+	return nil
+}
+
+func (k *KeltnerValue) inLocation(loc *time.Location) {
+	k.Datetime = model.InLocation(k.Datetime, loc)
+}
+
+// KeltnerRequest - builds a Keltner request to queue on a batch.Batch
+func KeltnerRequest(symbol string, interval model.Interval, opts KeltnerOptions) batch.Request[IndicatorResponse[KeltnerValue, KeltnerIndicator]] {
+	return request[KeltnerValue, KeltnerIndicator]("keltner", symbol, interval, opts)
+}
+
+// BBANDS - gets the Bollinger Bands: https://twelvedata.com/docs#bbands
+func (c *client) BBANDS(symbol string, interval model.Interval, opts BBANDSOptions) (IndicatorResponse[BBANDSValue, BBANDSIndicator], error) {
+	return c.BBANDSWithContext(context.Background(), symbol, interval, opts)
+}
+
+// BBANDSWithContext - same as BBANDS, but bound to ctx
+func (c *client) BBANDSWithContext(ctx context.Context, symbol string, interval model.Interval, opts BBANDSOptions) (IndicatorResponse[BBANDSValue, BBANDSIndicator], error) {
+	return indicator[BBANDSValue, BBANDSIndicator](ctx, c, "bbands", symbol, interval, opts)
+}
+
+// ATR - gets the Average True Range: https://twelvedata.com/docs#atr
+func (c *client) ATR(symbol string, interval model.Interval, opts ATROptions) (IndicatorResponse[ATRValue, ATRIndicator], error) {
+	return c.ATRWithContext(context.Background(), symbol, interval, opts)
+}
+
+// ATRWithContext - same as ATR, but bound to ctx
+func (c *client) ATRWithContext(ctx context.Context, symbol string, interval model.Interval, opts ATROptions) (IndicatorResponse[ATRValue, ATRIndicator], error) {
+	return indicator[ATRValue, ATRIndicator](ctx, c, "atr", symbol, interval, opts)
+}
+
+// NATR - gets the Normalized Average True Range: https://twelvedata.com/docs#natr
+func (c *client) NATR(symbol string, interval model.Interval, opts ATROptions) (IndicatorResponse[NATRValue, ATRIndicator], error) {
+	return c.NATRWithContext(context.Background(), symbol, interval, opts)
+}
+
+// NATRWithContext - same as NATR, but bound to ctx
+func (c *client) NATRWithContext(ctx context.Context, symbol string, interval model.Interval, opts ATROptions) (IndicatorResponse[NATRValue, ATRIndicator], error) {
+	return indicator[NATRValue, ATRIndicator](ctx, c, "natr", symbol, interval, opts)
+}
+
+// Keltner - gets the Keltner Channels: https://twelvedata.com/docs#keltner
+func (c *client) Keltner(symbol string, interval model.Interval, opts KeltnerOptions) (IndicatorResponse[KeltnerValue, KeltnerIndicator], error) {
+	return c.KeltnerWithContext(context.Background(), symbol, interval, opts)
+}
+
+// KeltnerWithContext - same as Keltner, but bound to ctx
+func (c *client) KeltnerWithContext(ctx context.Context, symbol string, interval model.Interval, opts KeltnerOptions) (IndicatorResponse[KeltnerValue, KeltnerIndicator], error) {
+	return indicator[KeltnerValue, KeltnerIndicator](ctx, c, "keltner", symbol, interval, opts)
+}
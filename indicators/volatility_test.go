@@ -0,0 +1,80 @@
+package indicators
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	bbandsBody  = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"BBANDS - Bollinger Bands","series_type":"close","time_period":20,"sd":2,"ma_type":"SMA"}},"values":[{"datetime":"2023-08-24","upper_band":"183.27021","middle_band":"178.90150","lower_band":"174.53279"}],"status":"ok"}`)
+	atrBody     = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"ATR - Average True Range","time_period":14}},"values":[{"datetime":"2023-08-24","atr":"3.21560"}],"status":"ok"}`)
+	natrBody    = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"NATR - Normalized Average True Range","time_period":14}},"values":[{"datetime":"2023-08-24","natr":"1.81125"}],"status":"ok"}`)
+	keltnerBody = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"KELTNER - Keltner Channels","series_type":"close","time_period":20,"atr_time_period":10,"multiplier":2,"ma_type":"EMA"}},"values":[{"datetime":"2023-08-24","upper_line":"185.01421","middle_line":"178.51023","lower_line":"172.00625"}],"status":"ok"}`)
+)
+
+func TestIntegrationBBANDS(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	_, err := client.BBANDS("AAPL", model.OneHour, BBANDSOptions{})
+	if err != nil {
+		t.Log("Failed to make BBANDS request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitVolatility(t *testing.T) {
+	var query url.Values
+	client := client{
+		c: http.DefaultClient,
+		getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+			query = u.Query()
+			return map[string][]byte{
+				"/bbands":  bbandsBody,
+				"/atr":     atrBody,
+				"/natr":    natrBody,
+				"/keltner": keltnerBody,
+			}[u.Path], nil
+		},
+	}
+
+	bbands, err := client.BBANDS("AAPL", model.OneDay, BBANDSOptions{TimePeriod: 20, SD: 2.5, MAType: MATypeEMA})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "20", query.Get("time_period"))
+		assert.Equal(t, "2.5", query.Get("sd"))
+		assert.Equal(t, "EMA", query.Get("ma_type"))
+		assert.Equal(t, MATypeSMA, bbands.Meta.Indicator.MAType)
+		assert.Equal(t, 183.27021, bbands.Values[0].UpperBand)
+		assert.Equal(t, 178.90150, bbands.Values[0].MiddleBand)
+		assert.Equal(t, 174.53279, bbands.Values[0].LowerBand)
+	}
+
+	atr, err := client.ATR("AAPL", model.OneDay, ATROptions{TimePeriod: 14})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "14", query.Get("time_period"))
+		assert.Equal(t, 3.21560, atr.Values[0].Atr)
+	}
+
+	natr, err := client.NATR("AAPL", model.OneDay, ATROptions{})
+	if assert.Nil(t, err) {
+		assert.Empty(t, query.Get("time_period"))
+		assert.Equal(t, 1.81125, natr.Values[0].Natr)
+	}
+
+	keltner, err := client.Keltner("AAPL", model.OneDay, KeltnerOptions{ATRTimePeriod: 10, Multiplier: 2})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "10", query.Get("atr_time_period"))
+		assert.Equal(t, "2", query.Get("multiplier"))
+		assert.Equal(t, 10, keltner.Meta.Indicator.ATRTimePeriod)
+		assert.Equal(t, 185.01421, keltner.Values[0].UpperLine)
+		assert.Equal(t, 172.00625, keltner.Values[0].LowerLine)
+	}
+}
@@ -0,0 +1,396 @@
+package indicators
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+)
+
+// VolumeIndicator - the Indicator value for IndicatorMeta shared by OBV and AD
+type VolumeIndicator struct {
+	Name       string `json:"name"`
+	SeriesType string `json:"series_type"`
+}
+
+// ADOSCIndicator - the Indicator value for IndicatorMeta specific for ADOSC
+type ADOSCIndicator struct {
+	Name       string `json:"name"`
+	FastPeriod int    `json:"fast_period"`
+	SlowPeriod int    `json:"slow_period"`
+}
+
+// MFIIndicator - the Indicator value for IndicatorMeta specific for MFI
+type MFIIndicator struct {
+	Name       string `json:"name"`
+	TimePeriod int    `json:"time_period"`
+}
+
+// VWAPIndicator - the Indicator value for IndicatorMeta specific for VWAP
+type VWAPIndicator struct {
+	Name         string     `json:"name"`
+	SDTimePeriod int        `json:"sd_time_period"`
+	SD           float64    `json:"sd"`
+	Anchor       VWAPAnchor `json:"anchor"`
+}
+
+// VWAPAnchor - the period VWAP accumulates over before resetting
+type VWAPAnchor string
+
+const (
+	VWAPAnchorSession VWAPAnchor = "session"
+	VWAPAnchorWeek    VWAPAnchor = "week"
+	VWAPAnchorMonth   VWAPAnchor = "month"
+	VWAPAnchorQuarter VWAPAnchor = "quarter"
+	VWAPAnchorYear    VWAPAnchor = "year"
+)
+
+// VolumeOptions - options for calling the twelvedata obv and ad endpoints: https://twelvedata.com/docs#obv
+type VolumeOptions struct {
+	IndicatorOptions
+}
+
+func (v VolumeOptions) params(u *url.URL, urlValues url.Values) {
+	u.RawQuery = v.IndicatorOptions.params(u, urlValues).Encode()
+}
+
+// ADOSCOptions - options for calling the twelvedata adosc endpoint: https://twelvedata.com/docs#adosc
+type ADOSCOptions struct {
+	IndicatorOptions
+	FastPeriod int
+	SlowPeriod int
+}
+
+func (a ADOSCOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = a.IndicatorOptions.params(u, urlValues)
+
+	if a.FastPeriod > 0 {
+		urlValues.Add("fast_period", strconv.Itoa(a.FastPeriod))
+	}
+
+	if a.SlowPeriod > 0 {
+		urlValues.Add("slow_period", strconv.Itoa(a.SlowPeriod))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// MFIOptions - options for calling the twelvedata mfi endpoint: https://twelvedata.com/docs#mfi
+type MFIOptions struct {
+	IndicatorOptions
+	TimePeriod int
+}
+
+func (m MFIOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = m.IndicatorOptions.params(u, urlValues)
+
+	if m.TimePeriod > 0 {
+		urlValues.Add("time_period", strconv.Itoa(m.TimePeriod))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// VWAPOptions - options for calling the twelvedata vwap endpoint: https://twelvedata.com/docs#vwap
+type VWAPOptions struct {
+	IndicatorOptions
+	// SDTimePeriod - the period of the standard deviation bands, which are only returned when set
+	SDTimePeriod int
+	SD           float64
+	// Anchor - the period VWAP resets on, defaults to every session
+	Anchor VWAPAnchor
+}
+
+func (v VWAPOptions) params(u *url.URL, urlValues url.Values) {
+	urlValues = v.IndicatorOptions.params(u, urlValues)
+
+	if v.SDTimePeriod > 0 {
+		urlValues.Add("sd_time_period", strconv.Itoa(v.SDTimePeriod))
+	}
+
+	if v.SD > 0 {
+		urlValues.Add("sd", strconv.FormatFloat(v.SD, 'f', -1, 64))
+	}
+
+	if v.Anchor != "" {
+		urlValues.Add("anchor", string(v.Anchor))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// OBVValue - the Indicator value for IndicatorResponse specific for OBV, zero where volume is missing e.g. forex pairs
+type OBVValue struct {
+	Datetime time.Time `json:"datetime"`
+	Obv      float64   `json:"obv"`
+}
+
+// UnmarshalJSON - unmarshal's OBVValue to a more consumable type
+func (o *OBVValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Obv      string `json:"obv"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	obv, err := parseVolume(value.Obv, "obv")
+	if err != nil {
+		return err
+	}
+
+	o.Datetime = dateTime
+	o.Obv = obv
+
+	return nil
+}
+
+func (o *OBVValue) inLocation(loc *time.Location) {
+	o.Datetime = model.InLocation(o.Datetime, loc)
+}
+
+// OBVRequest - builds a OBV request to queue on a batch.Batch
+func OBVRequest(symbol string, interval model.Interval, opts VolumeOptions) batch.Request[IndicatorResponse[OBVValue, VolumeIndicator]] {
+	return request[OBVValue, VolumeIndicator]("obv", symbol, interval, opts)
+}
+
+// ADValue - the Indicator value for IndicatorResponse specific for AD, zero where volume is missing e.g. forex pairs
+type ADValue struct {
+	Datetime time.Time `json:"datetime"`
+	Ad       float64   `json:"ad"`
+}
+
+// UnmarshalJSON - unmarshal's ADValue to a more consumable type
+func (a *ADValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Ad       string `json:"ad"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	ad, err := parseVolume(value.Ad, "ad")
+	if err != nil {
+		return err
+	}
+
+	a.Datetime = dateTime
+	a.Ad = ad
+
+	return nil
+}
+
+func (a *ADValue) inLocation(loc *time.Location) {
+	a.Datetime = model.InLocation(a.Datetime, loc)
+}
+
+// ADRequest - builds a AD request to queue on a batch.Batch
+func ADRequest(symbol string, interval model.Interval, opts VolumeOptions) batch.Request[IndicatorResponse[ADValue, VolumeIndicator]] {
+	return request[ADValue, VolumeIndicator]("ad", symbol, interval, opts)
+}
+
+// ADOSCValue - the Indicator value for IndicatorResponse specific for ADOSC
+type ADOSCValue struct {
+	Datetime time.Time `json:"datetime"`
+	Adosc    float64   `json:"adosc"`
+}
+
+// UnmarshalJSON - unmarshal's ADOSCValue to a more consumable type
+func (a *ADOSCValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Adosc    string `json:"adosc"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	adosc, err := parseVolume(value.Adosc, "adosc")
+	if err != nil {
+		return err
+	}
+
+	a.Datetime = dateTime
+	a.Adosc = adosc
+
+	return nil
+}
+
+func (a *ADOSCValue) inLocation(loc *time.Location) {
+	a.Datetime = model.InLocation(a.Datetime, loc)
+}
+
+// ADOSCRequest - builds a ADOSC request to queue on a batch.Batch
+func ADOSCRequest(symbol string, interval model.Interval, opts ADOSCOptions) batch.Request[IndicatorResponse[ADOSCValue, ADOSCIndicator]] {
+	return request[ADOSCValue, ADOSCIndicator]("adosc", symbol, interval, opts)
+}
+
+// MFIValue - the Indicator value for IndicatorResponse specific for MFI
+type MFIValue struct {
+	Datetime time.Time `json:"datetime"`
+	Mfi      float64   `json:"mfi"`
+}
+
+// UnmarshalJSON - unmarshal's MFIValue to a more consumable type
+func (m *MFIValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime string `json:"datetime"`
+		Mfi      string `json:"mfi"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	mfi, err := parseVolume(value.Mfi, "mfi")
+	if err != nil {
+		return err
+	}
+
+	m.Datetime = dateTime
+	m.Mfi = mfi
+
+	return nil
+}
+
+func (m *MFIValue) inLocation(loc *time.Location) {
+	m.Datetime = model.InLocation(m.Datetime, loc)
+}
+
+// MFIRequest - builds a MFI request to queue on a batch.Batch
+func MFIRequest(symbol string, interval model.Interval, opts MFIOptions) batch.Request[IndicatorResponse[MFIValue, MFIIndicator]] {
+	return request[MFIValue, MFIIndicator]("mfi", symbol, interval, opts)
+}
+
+// VWAPValue - the Indicator value for IndicatorResponse specific for VWAP, the bands are nil unless
+// VWAPOptions.SDTimePeriod is set
+type VWAPValue struct {
+	Datetime  time.Time `json:"datetime"`
+	Vwap      *float64  `json:"vwap"`
+	UpperBand *float64  `json:"upper_band"`
+	LowerBand *float64  `json:"lower_band"`
+}
+
+// UnmarshalJSON - unmarshal's VWAPValue to a more consumable type
+func (vw *VWAPValue) UnmarshalJSON(v []byte) error {
+	var value struct {
+		Datetime  string `json:"datetime"`
+		Vwap      string `json:"vwap"`
+		UpperBand string `json:"upper_band"`
+		LowerBand string `json:"lower_band"`
+	}
+	if err := json.Unmarshal(v, &value); err != nil {
+		return err
+	}
+
+	dateTime, err := parseDatetime(value.Datetime)
+	if err != nil {
+		return err
+	}
+
+	vwap, err := parseOptionalFloat(value.Vwap, "vwap")
+	if err != nil {
+		return err
+	}
+
+	upperBand, err := parseOptionalFloat(value.UpperBand, "upper_band")
+	if err != nil {
+		return err
+	}
+
+	lowerBand, err := parseOptionalFloat(value.LowerBand, "lower_band")
+	if err != nil {
+		return err
+	}
+
+	vw.Datetime = dateTime
+	vw.Vwap = vwap
+	vw.UpperBand = upperBand
+	vw.LowerBand = lowerBand
+
+	return nil
+}
+
+func (vw *VWAPValue) inLocation(loc *time.Location) {
+	vw.Datetime = model.InLocation(vw.Datetime, loc)
+}
+
+// VWAPRequest - builds a VWAP request to queue on a batch.Batch
+func VWAPRequest(symbol string, interval model.Interval, opts VWAPOptions) batch.Request[IndicatorResponse[VWAPValue, VWAPIndicator]] {
+	return request[VWAPValue, VWAPIndicator]("vwap", symbol, interval, opts)
+}
+
+// OBV - gets the On Balance Volume: https://twelvedata.com/docs#obv
+func (c *client) OBV(symbol string, interval model.Interval, opts VolumeOptions) (IndicatorResponse[OBVValue, VolumeIndicator], error) {
+	return c.OBVWithContext(context.Background(), symbol, interval, opts)
+}
+
+// OBVWithContext - same as OBV, but bound to ctx
+func (c *client) OBVWithContext(ctx context.Context, symbol string, interval model.Interval, opts VolumeOptions) (IndicatorResponse[OBVValue, VolumeIndicator], error) {
+	return indicator[OBVValue, VolumeIndicator](ctx, c, "obv", symbol, interval, opts)
+}
+
+// AD - gets the Chaikin A/D Line: https://twelvedata.com/docs#ad
+func (c *client) AD(symbol string, interval model.Interval, opts VolumeOptions) (IndicatorResponse[ADValue, VolumeIndicator], error) {
+	return c.ADWithContext(context.Background(), symbol, interval, opts)
+}
+
+// ADWithContext - same as AD, but bound to ctx
+func (c *client) ADWithContext(ctx context.Context, symbol string, interval model.Interval, opts VolumeOptions) (IndicatorResponse[ADValue, VolumeIndicator], error) {
+	return indicator[ADValue, VolumeIndicator](ctx, c, "ad", symbol, interval, opts)
+}
+
+// ADOSC - gets the Chaikin A/D Oscillator: https://twelvedata.com/docs#adosc
+func (c *client) ADOSC(symbol string, interval model.Interval, opts ADOSCOptions) (IndicatorResponse[ADOSCValue, ADOSCIndicator], error) {
+	return c.ADOSCWithContext(context.Background(), symbol, interval, opts)
+}
+
+// ADOSCWithContext - same as ADOSC, but bound to ctx
+func (c *client) ADOSCWithContext(ctx context.Context, symbol string, interval model.Interval, opts ADOSCOptions) (IndicatorResponse[ADOSCValue, ADOSCIndicator], error) {
+	return indicator[ADOSCValue, ADOSCIndicator](ctx, c, "adosc", symbol, interval, opts)
+}
+
+// MFI - gets the Money Flow Index: https://twelvedata.com/docs#mfi
+func (c *client) MFI(symbol string, interval model.Interval, opts MFIOptions) (IndicatorResponse[MFIValue, MFIIndicator], error) {
+	return c.MFIWithContext(context.Background(), symbol, interval, opts)
+}
+
+// MFIWithContext - same as MFI, but bound to ctx
+func (c *client) MFIWithContext(ctx context.Context, symbol string, interval model.Interval, opts MFIOptions) (IndicatorResponse[MFIValue, MFIIndicator], error) {
+	return indicator[MFIValue, MFIIndicator](ctx, c, "mfi", symbol, interval, opts)
+}
+
+// VWAP - gets the Volume Weighted Average Price: https://twelvedata.com/docs#vwap
+func (c *client) VWAP(symbol string, interval model.Interval, opts VWAPOptions) (IndicatorResponse[VWAPValue, VWAPIndicator], error) {
+	return c.VWAPWithContext(context.Background(), symbol, interval, opts)
+}
+
+// VWAPWithContext - same as VWAP, but bound to ctx
+func (c *client) VWAPWithContext(ctx context.Context, symbol string, interval model.Interval, opts VWAPOptions) (IndicatorResponse[VWAPValue, VWAPIndicator], error) {
+	return indicator[VWAPValue, VWAPIndicator](ctx, c, "vwap", symbol, interval, opts)
+}
@@ -0,0 +1,563 @@
+package indicators
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/DefinitelyNotAGoat/twelvedata/model"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	obvBody         = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"OBV - On Balance Volume","series_type":"close"}},"values":[{"datetime":"2023-08-24","obv":"1046281905"},{"datetime":"2023-08-23","obv":"1097542105"},{"datetime":"2023-08-22","obv":"1034101605"}],"status":"ok"}`)
+	obvForexBody    = []byte(`{"meta":{"symbol":"EUR/USD","interval":"1day","currency_base":"Euro","currency_quote":"US Dollar","exchange_timezone":"UTC","type":"Physical Currency","indicator":{"name":"OBV - On Balance Volume","series_type":"close"}},"values":[{"datetime":"2023-08-24","open":"1.08657","high":"1.08713","low":"1.08055","close":"1.08102","volume":"","obv":""},{"datetime":"2023-08-23","open":"1.08472","high":"1.08764","low":"1.07997","close":"1.08653","volume":"","obv":""},{"datetime":"2023-08-22","open":"1.08956","high":"1.09104","low":"1.08024","close":"1.08474","volume":"","obv":""}],"status":"ok"}`)
+	adBody          = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"AD - Chaikin A/D Line"}},"values":[{"datetime":"2023-08-24","ad":"3317265148.44530"},{"datetime":"2023-08-23","ad":"3359573281.16797"},{"datetime":"2023-08-22","ad":"3331614745.73145"}],"status":"ok"}`)
+	adForexBody     = []byte(`{"meta":{"symbol":"EUR/USD","interval":"1day","currency_base":"Euro","currency_quote":"US Dollar","exchange_timezone":"UTC","type":"Physical Currency","indicator":{"name":"AD - Chaikin A/D Line"}},"values":[{"datetime":"2023-08-24","open":"1.08657","high":"1.08713","low":"1.08055","close":"1.08102","volume":"","ad":""},{"datetime":"2023-08-23","open":"1.08472","high":"1.08764","low":"1.07997","close":"1.08653","volume":"","ad":""},{"datetime":"2023-08-22","open":"1.08956","high":"1.09104","low":"1.08024","close":"1.08474","volume":"","ad":""}],"status":"ok"}`)
+	adoscBody       = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"ADOSC - Chaikin A/D Oscillator","fast_period":3,"slow_period":10}},"values":[{"datetime":"2023-08-24","adosc":"-15203851.70518"},{"datetime":"2023-08-23","adosc":"1806723.16281"},{"datetime":"2023-08-22","adosc":"-8637462.25610"}],"status":"ok"}`)
+	mfiBody         = []byte(`{"meta":{"symbol":"AAPL","interval":"1day","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"MFI - Money Flow Index","time_period":14}},"values":[{"datetime":"2023-08-24","mfi":"33.38146"},{"datetime":"2023-08-23","mfi":"38.30427"},{"datetime":"2023-08-22","mfi":"33.18420"}],"status":"ok"}`)
+	vwapBody        = []byte(`{"meta":{"symbol":"AAPL","interval":"1min","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"VWAP - Volume Weighted Average Price","sd_time_period":20,"sd":2,"anchor":"week"}},"values":[{"datetime":"2023-08-24 11:09:00","vwap":"178.01202","upper_band":"178.51877","lower_band":"177.50527"},{"datetime":"2023-08-24 11:08:00","vwap":"178.01785","upper_band":"178.52617","lower_band":"177.50953"},{"datetime":"2023-08-24 11:07:00","vwap":"178.02391","upper_band":"178.53413","lower_band":"177.51369"}],"status":"ok"}`)
+	vwapNoBandsBody = []byte(`{"meta":{"symbol":"MSFT","interval":"1min","currency":"USD","exchange_timezone":"America/New_York","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","indicator":{"name":"VWAP - Volume Weighted Average Price"}},"values":[{"datetime":"2023-08-24 11:09:00","vwap":"321.84213","upper_band":"","lower_band":""},{"datetime":"2023-08-24 11:08:00","vwap":"321.85066","upper_band":"","lower_band":""},{"datetime":"2023-08-24 11:07:00","vwap":"321.86240","upper_band":"","lower_band":""}],"status":"ok"}`)
+)
+
+func TestIntegrationOBV(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	_, err := client.OBV("AAPL", model.OneHour, VolumeOptions{})
+	if err != nil {
+		t.Log("Failed to make OBV request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitOBV(t *testing.T) {
+	type input struct {
+		getFn  getFn
+		symbol string
+		opts   VolumeOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator VolumeIndicator
+		values    int
+		first     OBVValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+				symbol: "AAPL",
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","obv":"abc"}],"status":"ok"}`), nil
+				},
+				symbol: "AAPL",
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value obv into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return obvBody, nil
+				},
+				symbol: "AAPL",
+				opts:   VolumeOptions{IndicatorOptions{SeriesType: SeriesTypeOpen}},
+			},
+			want{
+				params:    url.Values{"series_type": {"open"}},
+				indicator: VolumeIndicator{Name: "OBV - On Balance Volume", SeriesType: "close"},
+				values:    3,
+				first:     OBVValue{Datetime: inNewYork(2023, time.August, 24), Obv: 1046281905},
+			},
+		},
+		{
+			"is successful for forex without volume",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return obvForexBody, nil
+				},
+				symbol: "EUR/USD",
+				opts:   VolumeOptions{IndicatorOptions{IncludeOHLC: true}},
+			},
+			want{
+				params:    url.Values{"include_ohlc": {"true"}},
+				indicator: VolumeIndicator{Name: "OBV - On Balance Volume", SeriesType: "close"},
+				values:    3,
+				first:     OBVValue{Datetime: time.Date(2023, time.August, 24, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.OBV(tt.input.symbol, model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/obv", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitAD(t *testing.T) {
+	type input struct {
+		getFn  getFn
+		symbol string
+		opts   VolumeOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator VolumeIndicator
+		values    int
+		first     ADValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+				symbol: "AAPL",
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","ad":"abc"}],"status":"ok"}`), nil
+				},
+				symbol: "AAPL",
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value ad into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return adBody, nil
+				},
+				symbol: "AAPL",
+			},
+			want{
+				indicator: VolumeIndicator{Name: "AD - Chaikin A/D Line"},
+				values:    3,
+				first:     ADValue{Datetime: inNewYork(2023, time.August, 24), Ad: 3317265148.4453},
+			},
+		},
+		{
+			"is successful for forex without volume",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return adForexBody, nil
+				},
+				symbol: "EUR/USD",
+				opts:   VolumeOptions{IndicatorOptions{IncludeOHLC: true}},
+			},
+			want{
+				indicator: VolumeIndicator{Name: "AD - Chaikin A/D Line"},
+				values:    3,
+				first:     ADValue{Datetime: time.Date(2023, time.August, 24, 0, 0, 0, 0, time.UTC)},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.AD(tt.input.symbol, model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/ad", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitADOSC(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  ADOSCOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator ADOSCIndicator
+		values    int
+		first     ADOSCValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","adosc":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value adosc into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return adoscBody, nil
+				},
+				opts: ADOSCOptions{FastPeriod: 3, SlowPeriod: 10},
+			},
+			want{
+				params:    url.Values{"fast_period": {"3"}, "slow_period": {"10"}},
+				indicator: ADOSCIndicator{Name: "ADOSC - Chaikin A/D Oscillator", FastPeriod: 3, SlowPeriod: 10},
+				values:    3,
+				first:     ADOSCValue{Datetime: inNewYork(2023, time.August, 24), Adosc: -15203851.70518},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.ADOSC("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/adosc", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitMFI(t *testing.T) {
+	type input struct {
+		getFn getFn
+		opts  MFIOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator MFIIndicator
+		values    int
+		first     MFIValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24","mfi":"abc"}],"status":"ok"}`), nil
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value mfi into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return mfiBody, nil
+				},
+				opts: MFIOptions{TimePeriod: 14},
+			},
+			want{
+				params:    url.Values{"time_period": {"14"}},
+				indicator: MFIIndicator{Name: "MFI - Money Flow Index", TimePeriod: 14},
+				values:    3,
+				first:     MFIValue{Datetime: inNewYork(2023, time.August, 24), Mfi: 33.38146},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.MFI("AAPL", model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/mfi", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
+
+func TestUnitVWAP(t *testing.T) {
+	type input struct {
+		getFn  getFn
+		symbol string
+		opts   VWAPOptions
+	}
+
+	type want struct {
+		err       bool
+		contains  string
+		params    url.Values
+		indicator VWAPIndicator
+		values    int
+		first     VWAPValue
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+				symbol: "AAPL",
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"handles a value that is not a number",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return []byte(`{"meta":{"exchange_timezone":"America/New_York"},"values":[{"datetime":"2023-08-24 11:09:00","vwap":"abc","upper_band":"1.5","lower_band":"1.5"}],"status":"ok"}`), nil
+				},
+				symbol: "AAPL",
+			},
+			want{
+				err:      true,
+				contains: "failed to parse value vwap into float",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return vwapBody, nil
+				},
+				symbol: "AAPL",
+				opts:   VWAPOptions{SDTimePeriod: 20, SD: 2, Anchor: VWAPAnchorWeek},
+			},
+			want{
+				params:    url.Values{"sd_time_period": {"20"}, "sd": {"2"}, "anchor": {"week"}},
+				indicator: VWAPIndicator{Name: "VWAP - Volume Weighted Average Price", SDTimePeriod: 20, SD: 2, Anchor: "week"},
+				values:    3,
+				first:     VWAPValue{Datetime: inNewYork(2023, time.August, 24).Add(11*time.Hour + 9*time.Minute), Vwap: ptr(178.01202), UpperBand: ptr(178.51877), LowerBand: ptr(177.50527)},
+			},
+		},
+		{
+			"is successful without bands",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return vwapNoBandsBody, nil
+				},
+				symbol: "MSFT",
+			},
+			want{
+				indicator: VWAPIndicator{Name: "VWAP - Volume Weighted Average Price"},
+				values:    3,
+				first:     VWAPValue{Datetime: inNewYork(2023, time.August, 24).Add(11*time.Hour + 9*time.Minute), Vwap: ptr(321.84213)},
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			var requested *url.URL
+			client := client{
+				c: http.DefaultClient,
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					requested = u
+					return tt.input.getFn(ctx, u)
+				},
+			}
+
+			response, err := client.VWAP(tt.input.symbol, model.OneDay, tt.input.opts)
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) {
+				assert.Equal(t, "/vwap", requested.Path)
+				for key := range tt.want.params {
+					assert.Equal(t, tt.want.params.Get(key), requested.Query().Get(key))
+				}
+				assert.Equal(t, tt.want.indicator, response.Meta.Indicator)
+				if assert.Len(t, response.Values, tt.want.values) {
+					assert.Equal(t, tt.want.first, response.Values[0])
+				}
+			}
+		})
+	}
+}
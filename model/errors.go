@@ -0,0 +1,54 @@
+package model
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// APIError - the error payload twelvedata responds with, either alongside a non-2xx status or inside a 200: https://twelvedata.com/docs#errors
+type APIError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Status  string `json:"status"`
+}
+
+// Error - implements the error interface
+func (a *APIError) Error() string {
+	return fmt.Sprintf("twelvedata api error '%d': %s", a.Code, a.Message)
+}
+
+// IsRateLimit - reports whether the API credits for the current minute or day have run out
+func (a *APIError) IsRateLimit() bool {
+	return a.Code == http.StatusTooManyRequests
+}
+
+// IsAuth - reports whether the api key is invalid or lacks access to the requested data
+func (a *APIError) IsAuth() bool {
+	return a.Code == http.StatusUnauthorized || a.Code == http.StatusForbidden
+}
+
+// IsNotFound - reports whether the requested symbol or data could not be found
+func (a *APIError) IsNotFound() bool {
+	return a.Code == http.StatusNotFound
+}
+
+// IsNoData - reports whether the request was valid but no data exists for the requested dates
+func (a *APIError) IsNoData() bool {
+	return a.Code == http.StatusBadRequest && strings.Contains(strings.ToLower(a.Message), "no data is available")
+}
+
+// CheckAPIError - returns an *APIError if body is a twelvedata error payload, otherwise nil
+func CheckAPIError(body []byte) error {
+	var apiErr APIError
+	if err := json.Unmarshal(body, &apiErr); err != nil {
+		return nil
+	}
+
+	if apiErr.Status != "error" {
+		return nil
+	}
+
+	return &apiErr
+}
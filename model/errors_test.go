@@ -0,0 +1,70 @@
+package model
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitCheckAPIError(t *testing.T) {
+	type want struct {
+		err       bool
+		code      int
+		rateLimit bool
+	}
+
+	cases := []struct {
+		name  string
+		input []byte
+		want  want
+	}{
+		{
+			"handles rate limit payload",
+			[]byte(`{"code":429,"message":"You have run out of API credits for the current minute.","status":"error"}`),
+			want{
+				err:       true,
+				code:      429,
+				rateLimit: true,
+			},
+		},
+		{
+			"handles bad symbol payload",
+			[]byte(`{"code":400,"message":"**symbol** not found: FOOBAR.","status":"error"}`),
+			want{
+				err:  true,
+				code: 400,
+			},
+		},
+		{
+			"ignores ok payload",
+			[]byte(`{"meta":{"symbol":"AAPL"},"values":[],"status":"ok"}`),
+			want{
+				err: false,
+			},
+		},
+		{
+			"ignores non object payload",
+			[]byte(`[{"symbol":"AAPL"}]`),
+			want{
+				err: false,
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckAPIError(tt.input)
+			if !tt.want.err {
+				assert.Nil(t, err)
+				return
+			}
+
+			var apiErr *APIError
+			if assert.True(t, errors.As(err, &apiErr)) {
+				assert.Equal(t, tt.want.code, apiErr.Code)
+				assert.Equal(t, tt.want.rateLimit, apiErr.IsRateLimit())
+			}
+		})
+	}
+}
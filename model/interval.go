@@ -0,0 +1,86 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+var intervalDurations = map[Interval]time.Duration{
+	OneMin:        time.Minute,
+	FiveMin:       5 * time.Minute,
+	FifteenMin:    15 * time.Minute,
+	ThirtyMin:     30 * time.Minute,
+	FourtyFiveMin: 45 * time.Minute,
+	OneHour:       time.Hour,
+	TwoHour:       2 * time.Hour,
+	FourHour:      4 * time.Hour,
+	EightHour:     8 * time.Hour,
+}
+
+var intervalCalendarSteps = map[Interval]struct{ months, days int }{
+	OneDay:   {0, 1},
+	OneWeek:  {0, 7},
+	OneMonth: {1, 0},
+}
+
+// ParseInterval - validates s as one of the intervals twelvedata supports
+func ParseInterval(s string) (Interval, error) {
+	interval := Interval(s)
+	if !interval.Valid() {
+		return "", fmt.Errorf("unsupported interval '%s'", s)
+	}
+
+	return interval, nil
+}
+
+// Valid - reports whether i is one of the intervals twelvedata supports
+func (i Interval) Valid() bool {
+	_, intraday := intervalDurations[i]
+	_, calendar := intervalCalendarSteps[i]
+	return intraday || calendar
+}
+
+// Duration - the fixed length of an intraday bar, false for 1day and longer which step by the calendar, see CalendarStep
+func (i Interval) Duration() (time.Duration, bool) {
+	d, ok := intervalDurations[i]
+	return d, ok
+}
+
+// CalendarStep - the months and days a bar of 1day or longer spans, as taken by time.AddDate; false for intraday intervals
+func (i Interval) CalendarStep() (months int, days int, ok bool) {
+	step, ok := intervalCalendarSteps[i]
+	return step.months, step.days, ok
+}
+
+// Add - moves t forward by n bars of i (backwards when n is negative), calendar steps keep the wall clock in t's location
+func (i Interval) Add(t time.Time, n int) time.Time {
+	if d, ok := i.Duration(); ok {
+		return t.Add(time.Duration(n) * d)
+	}
+
+	months, days, _ := i.CalendarStep()
+	return t.AddDate(0, n*months, n*days)
+}
+
+// Truncate - aligns t to the start of the bar of i containing it, in t's location.
+// Intraday bars are aligned from midnight, weeks start on Monday and months on the first.
+func (i Interval) Truncate(t time.Time) time.Time {
+	year, month, day := t.Date()
+	midnight := time.Date(year, month, day, 0, 0, 0, 0, t.Location())
+
+	if d, ok := i.Duration(); ok {
+		minutes, step := t.Hour()*60+t.Minute(), int(d/time.Minute)
+		minutes -= minutes % step
+		return time.Date(year, month, day, minutes/60, minutes%60, 0, 0, t.Location())
+	}
+
+	switch i {
+	case OneWeek:
+		offset := (int(midnight.Weekday()) + 6) % 7
+		return midnight.AddDate(0, 0, -offset)
+	case OneMonth:
+		return time.Date(year, month, 1, 0, 0, 0, 0, t.Location())
+	default:
+		return midnight
+	}
+}
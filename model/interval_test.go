@@ -0,0 +1,84 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUnitParseInterval(t *testing.T) {
+	for _, interval := range Intervals {
+		parsed, err := ParseInterval(string(interval))
+		assert.Nil(t, err)
+		assert.Equal(t, interval, parsed)
+		assert.Contains(t, TimeFormatMap, interval)
+	}
+
+	_, err := ParseInterval("3min")
+	if assert.NotNil(t, err) {
+		assert.Contains(t, err.Error(), "unsupported interval '3min'")
+	}
+}
+
+func TestUnitIntervalStep(t *testing.T) {
+	d, ok := FourtyFiveMin.Duration()
+	assert.True(t, ok)
+	assert.Equal(t, 45*time.Minute, d)
+
+	_, ok = OneDay.Duration()
+	assert.False(t, ok)
+
+	months, days, ok := OneMonth.CalendarStep()
+	assert.True(t, ok)
+	assert.Equal(t, 1, months)
+	assert.Equal(t, 0, days)
+
+	_, _, ok = EightHour.CalendarStep()
+	assert.False(t, ok)
+}
+
+func TestUnitIntervalAdd(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	start := time.Date(2023, 1, 31, 9, 30, 0, 0, newYork)
+
+	assert.Equal(t, time.Date(2023, 1, 31, 11, 0, 0, 0, newYork), FifteenMin.Add(start, 6))
+	assert.Equal(t, time.Date(2023, 1, 30, 9, 30, 0, 0, newYork), OneDay.Add(start, -1))
+	assert.Equal(t, time.Date(2023, 3, 3, 9, 30, 0, 0, newYork), OneMonth.Add(start, 1))
+	// a daily step over the DST change keeps the wall clock
+	assert.Equal(t, time.Date(2023, 3, 13, 9, 30, 0, 0, newYork), OneDay.Add(time.Date(2023, 3, 12, 9, 30, 0, 0, newYork), 1))
+}
+
+func TestUnitIntervalTruncate(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if !assert.Nil(t, err) {
+		t.FailNow()
+	}
+
+	// Thursday
+	at := time.Date(2023, 8, 24, 11, 9, 42, 5, newYork)
+
+	cases := []struct {
+		interval Interval
+		want     time.Time
+	}{
+		{OneMin, time.Date(2023, 8, 24, 11, 9, 0, 0, newYork)},
+		{FiveMin, time.Date(2023, 8, 24, 11, 5, 0, 0, newYork)},
+		{FourtyFiveMin, time.Date(2023, 8, 24, 10, 30, 0, 0, newYork)},
+		{TwoHour, time.Date(2023, 8, 24, 10, 0, 0, 0, newYork)},
+		{EightHour, time.Date(2023, 8, 24, 8, 0, 0, 0, newYork)},
+		{OneDay, time.Date(2023, 8, 24, 0, 0, 0, 0, newYork)},
+		{OneWeek, time.Date(2023, 8, 21, 0, 0, 0, 0, newYork)},
+		{OneMonth, time.Date(2023, 8, 1, 0, 0, 0, 0, newYork)},
+	}
+
+	for _, tt := range cases {
+		t.Run(string(tt.interval), func(t *testing.T) {
+			assert.Equal(t, tt.want, tt.interval.Truncate(at))
+		})
+	}
+}
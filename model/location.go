@@ -0,0 +1,54 @@
+package model
+
+import (
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// ExchangeTimezone - the timezone request parameter value asking for datetimes in the exchange's local time, twelvedata's default
+const ExchangeTimezone = "Exchange"
+
+var locations sync.Map
+
+// LoadLocation - loads a twelvedata timezone such as meta.exchange_timezone, an empty name is UTC
+func LoadLocation(name string) (*time.Location, error) {
+	if name == "" {
+		return time.UTC, nil
+	}
+
+	if loc, ok := locations.Load(name); ok {
+		return loc.(*time.Location), nil
+	}
+
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load timezone '%s'", name)
+	}
+	locations.Store(name, loc)
+
+	return loc, nil
+}
+
+// ResponseLocation - the location the datetimes of a response are expressed in:
+// the requested timezone parameter when given, otherwise the exchange's timezone from meta
+func ResponseLocation(requested, exchange string) (*time.Location, error) {
+	if requested != "" && requested != ExchangeTimezone {
+		return LoadLocation(requested)
+	}
+
+	return LoadLocation(exchange)
+}
+
+// Localizer - implemented by responses whose datetimes twelvedata returns without an offset
+type Localizer interface {
+	// Localize - places every datetime of the response in the timezone it was requested with, see ResponseLocation
+	Localize(timezone string) error
+}
+
+// InLocation - reinterprets the wall clock of t in loc, twelvedata datetimes carry no offset so they parse as UTC
+func InLocation(t time.Time, loc *time.Location) time.Time {
+	year, month, day := t.Date()
+	return time.Date(year, month, day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)
+}
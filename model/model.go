@@ -5,26 +5,50 @@ import "strings"
 type Interval string
 
 const (
-	//OneMin        Interval = "1min"
-	//FiveMin       Interval = "5min"
-	//FifteenMin    Interval = "15min"
-	//ThirtyMin     Interval = "30min"
-	//FourtyFiveMin Interval = "45min"
-	OneHour Interval = "1h"
-	//TwoHour       Interval = "2h"
-	FourHour Interval = "4h"
-	OneDay   Interval = "1day"
-	OneWeek  Interval = "1week"
-	OneMonth Interval = "1month"
+	OneMin        Interval = "1min"
+	FiveMin       Interval = "5min"
+	FifteenMin    Interval = "15min"
+	ThirtyMin     Interval = "30min"
+	FourtyFiveMin Interval = "45min"
+	OneHour       Interval = "1h"
+	TwoHour       Interval = "2h"
+	FourHour      Interval = "4h"
+	EightHour     Interval = "8h"
+	OneDay        Interval = "1day"
+	OneWeek       Interval = "1week"
+	OneMonth      Interval = "1month"
 )
 
 var (
+	// Intervals - every interval twelvedata supports, shortest first
+	Intervals = []Interval{
+		OneMin,
+		FiveMin,
+		FifteenMin,
+		ThirtyMin,
+		FourtyFiveMin,
+		OneHour,
+		TwoHour,
+		FourHour,
+		EightHour,
+		OneDay,
+		OneWeek,
+		OneMonth,
+	}
+
 	TimeFormatMap map[Interval]string = map[Interval]string{
-		OneHour:  "2006-01-02 15:04:05",
-		FourHour: "2006-01-02 15:04:05",
-		OneDay:   "2006-01-02",
-		OneWeek:  "2006-01-02",
-		OneMonth: "2006-01-02",
+		OneMin:        "2006-01-02 15:04:05",
+		FiveMin:       "2006-01-02 15:04:05",
+		FifteenMin:    "2006-01-02 15:04:05",
+		ThirtyMin:     "2006-01-02 15:04:05",
+		FourtyFiveMin: "2006-01-02 15:04:05",
+		OneHour:       "2006-01-02 15:04:05",
+		TwoHour:       "2006-01-02 15:04:05",
+		FourHour:      "2006-01-02 15:04:05",
+		EightHour:     "2006-01-02 15:04:05",
+		OneDay:        "2006-01-02",
+		OneWeek:       "2006-01-02",
+		OneMonth:      "2006-01-02",
 	}
 )
 
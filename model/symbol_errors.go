@@ -0,0 +1,26 @@
+package model
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// SymbolErrors - the per-symbol failures of a multi-symbol request, keyed by symbol
+type SymbolErrors map[string]error
+
+// Error - implements the error interface
+func (s SymbolErrors) Error() string {
+	symbols := make([]string, 0, len(s))
+	for symbol := range s {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	msgs := make([]string, 0, len(symbols))
+	for _, symbol := range symbols {
+		msgs = append(msgs, fmt.Sprintf("%s: %s", symbol, s[symbol].Error()))
+	}
+
+	return fmt.Sprintf("%d symbol(s) failed: %s", len(s), strings.Join(msgs, "; "))
+}
@@ -0,0 +1,108 @@
+package reference
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+)
+
+// Exchange - a stock exchange twelvedata supports: https://twelvedata.com/docs#exchanges
+type Exchange struct {
+	Name     string `json:"name"`
+	Code     string `json:"code"`
+	Country  string `json:"country"`
+	Timezone string `json:"timezone"`
+}
+
+// CryptocurrencyExchange - a cryptocurrency exchange twelvedata supports: https://twelvedata.com/docs#cryptocurrency-exchanges
+type CryptocurrencyExchange struct {
+	Name string `json:"name"`
+}
+
+// Country - a country twelvedata supports: https://twelvedata.com/docs#countries
+type Country struct {
+	ISO2         string `json:"iso2"`
+	ISO3         string `json:"iso3"`
+	Numeric      string `json:"numeric"`
+	Name         string `json:"name"`
+	OfficialName string `json:"official_name"`
+	Capital      string `json:"capital"`
+	Currency     string `json:"currency"`
+}
+
+// TechnicalIndicator - a technical indicator twelvedata supports: https://twelvedata.com/docs#technical-indicators-interface
+type TechnicalIndicator struct {
+	Enable      bool   `json:"enable"`
+	FullName    string `json:"full_name"`
+	Description string `json:"description"`
+	Type        string `json:"type"`
+	Overlay     bool   `json:"overlay"`
+	// Parameters - the indicator's parameters keyed by name, describing their types and defaults
+	Parameters map[string]json.RawMessage `json:"parameters"`
+	// OutputValues - the indicator's output lines keyed by name
+	OutputValues map[string]json.RawMessage `json:"output_values"`
+}
+
+// Exchanges - lists the stock exchanges twelvedata supports, filtered by opts
+func (c *client) Exchanges(opts ListOptions) ([]Exchange, error) {
+	return c.ExchangesWithContext(context.Background(), opts)
+}
+
+// ExchangesWithContext - same as Exchanges, but bound to ctx
+func (c *client) ExchangesWithContext(ctx context.Context, opts ListOptions) ([]Exchange, error) {
+	return list[Exchange](ctx, c, "exchanges", opts)
+}
+
+// CryptocurrencyExchanges - lists the cryptocurrency exchanges twelvedata supports
+func (c *client) CryptocurrencyExchanges() ([]CryptocurrencyExchange, error) {
+	return c.CryptocurrencyExchangesWithContext(context.Background())
+}
+
+// CryptocurrencyExchangesWithContext - same as CryptocurrencyExchanges, but bound to ctx
+func (c *client) CryptocurrencyExchangesWithContext(ctx context.Context) ([]CryptocurrencyExchange, error) {
+	return list[CryptocurrencyExchange](ctx, c, "cryptocurrency_exchanges", ListOptions{})
+}
+
+// Countries - lists the countries twelvedata supports
+func (c *client) Countries() ([]Country, error) {
+	return c.CountriesWithContext(context.Background())
+}
+
+// CountriesWithContext - same as Countries, but bound to ctx
+func (c *client) CountriesWithContext(ctx context.Context) ([]Country, error) {
+	return list[Country](ctx, c, "countries", ListOptions{})
+}
+
+// InstrumentTypes - lists the instrument types twelvedata supports, as accepted by ListOptions.Type
+func (c *client) InstrumentTypes() ([]string, error) {
+	return c.InstrumentTypesWithContext(context.Background())
+}
+
+// InstrumentTypesWithContext - same as InstrumentTypes, but bound to ctx
+func (c *client) InstrumentTypesWithContext(ctx context.Context) ([]string, error) {
+	var response struct {
+		Result []string `json:"result"`
+	}
+	if err := c.get(ctx, "instrument_type", url.Values{}, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Result, nil
+}
+
+// TechnicalIndicators - lists the technical indicators twelvedata supports, keyed by endpoint name
+func (c *client) TechnicalIndicators() (map[string]TechnicalIndicator, error) {
+	return c.TechnicalIndicatorsWithContext(context.Background())
+}
+
+// TechnicalIndicatorsWithContext - same as TechnicalIndicators, but bound to ctx
+func (c *client) TechnicalIndicatorsWithContext(ctx context.Context) (map[string]TechnicalIndicator, error) {
+	var response struct {
+		Data map[string]TechnicalIndicator `json:"data"`
+	}
+	if err := c.get(ctx, "technical_indicators", url.Values{}, &response); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
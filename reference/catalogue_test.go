@@ -0,0 +1,65 @@
+package reference
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	exchangesBody           = []byte(`{"data":[{"name":"NASDAQ","code":"XNGS","country":"United States","timezone":"America/New_York"}],"status":"ok"}`)
+	cryptoExchangesBody     = []byte(`{"data":[{"name":"Binance"},{"name":"Coinbase Pro"}],"status":"ok"}`)
+	countriesBody           = []byte(`{"data":[{"iso2":"US","iso3":"USA","numeric":"840","name":"United States","official_name":"United States of America","capital":"Washington D.C.","currency":"USD"}],"status":"ok"}`)
+	instrumentTypeBody      = []byte(`{"result":["Common Stock","ETF","Mutual Fund"],"status":"ok"}`)
+	technicalIndicatorsBody = []byte(`{"data":{"ema":{"enable":true,"full_name":"Exponential Moving Average","description":"Exponential Moving Average(EMA) places greater importance on recent data points than the normal Moving Average(MA).","type":"Overlap Studies","overlay":true,"parameters":{"time_period":{"default":9,"range":[1,800],"type":"int"}},"output_values":{"ema":{"default_color":"#FF0000","display":"line"}}}},"status":"ok"}`)
+)
+
+func TestUnitCatalogue(t *testing.T) {
+	var query url.Values
+	client := client{
+		c: http.DefaultClient,
+		getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+			query = u.Query()
+			return map[string][]byte{
+				"/exchanges":                exchangesBody,
+				"/cryptocurrency_exchanges": cryptoExchangesBody,
+				"/countries":                countriesBody,
+				"/instrument_type":          instrumentTypeBody,
+				"/technical_indicators":     technicalIndicatorsBody,
+			}[u.Path], nil
+		},
+	}
+
+	exchanges, err := client.Exchanges(ListOptions{Country: "United States"})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "United States", query.Get("country"))
+		assert.Equal(t, []Exchange{{Name: "NASDAQ", Code: "XNGS", Country: "United States", Timezone: "America/New_York"}}, exchanges)
+	}
+
+	cryptoExchanges, err := client.CryptocurrencyExchanges()
+	if assert.Nil(t, err) {
+		assert.Equal(t, []CryptocurrencyExchange{{Name: "Binance"}, {Name: "Coinbase Pro"}}, cryptoExchanges)
+	}
+
+	countries, err := client.Countries()
+	if assert.Nil(t, err) && assert.Len(t, countries, 1) {
+		assert.Equal(t, "USA", countries[0].ISO3)
+		assert.Equal(t, "840", countries[0].Numeric)
+	}
+
+	types, err := client.InstrumentTypes()
+	if assert.Nil(t, err) {
+		assert.Equal(t, []string{"Common Stock", "ETF", "Mutual Fund"}, types)
+	}
+
+	indicators, err := client.TechnicalIndicators()
+	if assert.Nil(t, err) && assert.Contains(t, indicators, "ema") {
+		assert.Equal(t, "Exponential Moving Average", indicators["ema"].FullName)
+		assert.True(t, indicators["ema"].Overlay)
+		assert.Contains(t, indicators["ema"].Parameters, "time_period")
+		assert.Contains(t, indicators["ema"].OutputValues, "ema")
+	}
+}
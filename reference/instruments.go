@@ -0,0 +1,193 @@
+package reference
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+)
+
+// SymbolMatch - an instrument matching a symbol search: https://twelvedata.com/docs#symbol-search
+type SymbolMatch struct {
+	Symbol           string `json:"symbol"`
+	InstrumentName   string `json:"instrument_name"`
+	Exchange         string `json:"exchange"`
+	MicCode          string `json:"mic_code"`
+	ExchangeTimezone string `json:"exchange_timezone"`
+	InstrumentType   string `json:"instrument_type"`
+	Country          string `json:"country"`
+	Currency         string `json:"currency"`
+}
+
+// Stock - a stock twelvedata supports: https://twelvedata.com/docs#stocks-list
+type Stock struct {
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name"`
+	Currency string `json:"currency"`
+	Exchange string `json:"exchange"`
+	MicCode  string `json:"mic_code"`
+	Country  string `json:"country"`
+	Type     string `json:"type"`
+}
+
+// ForexPair - a forex pair twelvedata supports: https://twelvedata.com/docs#forex-pairs-list
+type ForexPair struct {
+	Symbol        string `json:"symbol"`
+	CurrencyGroup string `json:"currency_group"`
+	CurrencyBase  string `json:"currency_base"`
+	CurrencyQuote string `json:"currency_quote"`
+}
+
+// Cryptocurrency - a cryptocurrency pair twelvedata supports: https://twelvedata.com/docs#cryptocurrencies-list
+type Cryptocurrency struct {
+	Symbol             string   `json:"symbol"`
+	AvailableExchanges []string `json:"available_exchanges"`
+	CurrencyBase       string   `json:"currency_base"`
+	CurrencyQuote      string   `json:"currency_quote"`
+}
+
+// ETF - an ETF twelvedata supports: https://twelvedata.com/docs#etf-list
+type ETF struct {
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name"`
+	Currency string `json:"currency"`
+	Exchange string `json:"exchange"`
+	MicCode  string `json:"mic_code"`
+	Country  string `json:"country"`
+}
+
+// Index - an index twelvedata supports: https://twelvedata.com/docs#indices-list
+type Index struct {
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name"`
+	Country  string `json:"country"`
+	Currency string `json:"currency"`
+	Exchange string `json:"exchange"`
+	MicCode  string `json:"mic_code"`
+}
+
+// Fund - a mutual fund twelvedata supports: https://twelvedata.com/docs#funds-list
+type Fund struct {
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name"`
+	Country  string `json:"country"`
+	Currency string `json:"currency"`
+	Exchange string `json:"exchange"`
+	MicCode  string `json:"mic_code"`
+	Type     string `json:"type"`
+}
+
+// Bond - a bond twelvedata supports: https://twelvedata.com/docs#bonds-list
+type Bond struct {
+	Symbol   string `json:"symbol"`
+	Name     string `json:"name"`
+	Country  string `json:"country"`
+	Currency string `json:"currency"`
+	Exchange string `json:"exchange"`
+	MicCode  string `json:"mic_code"`
+	Type     string `json:"type"`
+}
+
+// SymbolSearchOptions - options for calling the twelvedata symbol search endpoint: https://twelvedata.com/docs#symbol-search
+type SymbolSearchOptions struct {
+	OutputSize int
+}
+
+func (s SymbolSearchOptions) params(u *url.URL, urlValues url.Values) {
+	if s.OutputSize > 0 {
+		urlValues.Add("outputsize", strconv.Itoa(s.OutputSize))
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// SymbolSearch - finds the instruments best matching query by symbol or name
+func (c *client) SymbolSearch(query string, opts SymbolSearchOptions) ([]SymbolMatch, error) {
+	return c.SymbolSearchWithContext(context.Background(), query, opts)
+}
+
+// SymbolSearchWithContext - same as SymbolSearch, but bound to ctx
+func (c *client) SymbolSearchWithContext(ctx context.Context, query string, opts SymbolSearchOptions) ([]SymbolMatch, error) {
+	u := &url.URL{}
+	opts.params(u, url.Values{
+		"symbol": {query},
+	})
+
+	var response struct {
+		Data []SymbolMatch `json:"data"`
+	}
+	if err := c.get(ctx, "symbol_search", u.Query(), &response); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// Stocks - lists the stocks twelvedata supports, filtered by opts
+func (c *client) Stocks(opts ListOptions) ([]Stock, error) {
+	return c.StocksWithContext(context.Background(), opts)
+}
+
+// StocksWithContext - same as Stocks, but bound to ctx
+func (c *client) StocksWithContext(ctx context.Context, opts ListOptions) ([]Stock, error) {
+	return list[Stock](ctx, c, "stocks", opts)
+}
+
+// ForexPairs - lists the forex pairs twelvedata supports, filtered by opts
+func (c *client) ForexPairs(opts ListOptions) ([]ForexPair, error) {
+	return c.ForexPairsWithContext(context.Background(), opts)
+}
+
+// ForexPairsWithContext - same as ForexPairs, but bound to ctx
+func (c *client) ForexPairsWithContext(ctx context.Context, opts ListOptions) ([]ForexPair, error) {
+	return list[ForexPair](ctx, c, "forex_pairs", opts)
+}
+
+// Cryptocurrencies - lists the cryptocurrency pairs twelvedata supports, filtered by opts
+func (c *client) Cryptocurrencies(opts ListOptions) ([]Cryptocurrency, error) {
+	return c.CryptocurrenciesWithContext(context.Background(), opts)
+}
+
+// CryptocurrenciesWithContext - same as Cryptocurrencies, but bound to ctx
+func (c *client) CryptocurrenciesWithContext(ctx context.Context, opts ListOptions) ([]Cryptocurrency, error) {
+	return list[Cryptocurrency](ctx, c, "cryptocurrencies", opts)
+}
+
+// ETFs - lists the ETFs twelvedata supports, filtered by opts
+func (c *client) ETFs(opts ListOptions) ([]ETF, error) {
+	return c.ETFsWithContext(context.Background(), opts)
+}
+
+// ETFsWithContext - same as ETFs, but bound to ctx
+func (c *client) ETFsWithContext(ctx context.Context, opts ListOptions) ([]ETF, error) {
+	return list[ETF](ctx, c, "etf", opts)
+}
+
+// Indices - lists the indices twelvedata supports, filtered by opts
+func (c *client) Indices(opts ListOptions) ([]Index, error) {
+	return c.IndicesWithContext(context.Background(), opts)
+}
+
+// IndicesWithContext - same as Indices, but bound to ctx
+func (c *client) IndicesWithContext(ctx context.Context, opts ListOptions) ([]Index, error) {
+	return list[Index](ctx, c, "indices", opts)
+}
+
+// Funds - lists the mutual funds twelvedata supports, filtered by opts
+func (c *client) Funds(opts ListOptions) ([]Fund, error) {
+	return c.FundsWithContext(context.Background(), opts)
+}
+
+// FundsWithContext - same as Funds, but bound to ctx
+func (c *client) FundsWithContext(ctx context.Context, opts ListOptions) ([]Fund, error) {
+	return resultList[Fund](ctx, c, "funds", opts)
+}
+
+// Bonds - lists the bonds twelvedata supports, filtered by opts
+func (c *client) Bonds(opts ListOptions) ([]Bond, error) {
+	return c.BondsWithContext(context.Background(), opts)
+}
+
+// BondsWithContext - same as Bonds, but bound to ctx
+func (c *client) BondsWithContext(ctx context.Context, opts ListOptions) ([]Bond, error) {
+	return resultList[Bond](ctx, c, "bonds", opts)
+}
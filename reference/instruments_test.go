@@ -0,0 +1,167 @@
+package reference
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	symbolSearchBody = []byte(`{"data":[{"symbol":"AAPL","instrument_name":"Apple Inc","exchange":"NASDAQ","mic_code":"XNGS","exchange_timezone":"America/New_York","instrument_type":"Common Stock","country":"United States","currency":"USD"},{"symbol":"AAPL","instrument_name":"Apple Inc","exchange":"BMV","mic_code":"XMEX","exchange_timezone":"America/Mexico_City","instrument_type":"Common Stock","country":"Mexico","currency":"MXN"}],"status":"ok"}`)
+	stocksBody       = []byte(`{"data":[{"symbol":"AAPL","name":"Apple Inc","currency":"USD","exchange":"NASDAQ","mic_code":"XNGS","country":"United States","type":"Common Stock"}],"status":"ok"}`)
+	cryptoBody       = []byte(`{"data":[{"symbol":"BTC/USD","available_exchanges":["Binance","Coinbase Pro"],"currency_base":"Bitcoin","currency_quote":"US Dollar"}],"status":"ok"}`)
+	fundsBody        = []byte(`{"result":{"count":1,"list":[{"symbol":"VTSAX","name":"Vanguard Total Stock Market Index Fund Admiral Shares","country":"United States","currency":"USD","exchange":"NASDAQ","mic_code":"XNMS","type":"Mutual Fund"}]},"status":"ok"}`)
+)
+
+func TestIntegrationSymbolSearch(t *testing.T) {
+	client := New(
+		os.Getenv("TWELVEDATA_API_KEY"),
+		http.DefaultClient,
+	)
+
+	_, err := client.SymbolSearch("AAPL", SymbolSearchOptions{})
+	if err != nil {
+		t.Log("Failed to make SymbolSearch request: ", err.Error())
+		t.Fail()
+	}
+}
+
+func TestUnitSymbolSearch(t *testing.T) {
+	type input struct {
+		getFn getFn
+	}
+
+	type want struct {
+		err      bool
+		contains string
+		matches  int
+	}
+
+	cases := []struct {
+		name  string
+		input input
+		want  want
+	}{
+		{
+			"handles failure to get",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					return nil, errors.New("failed to get")
+				},
+			},
+			want{
+				err:      true,
+				contains: "failed to get",
+			},
+		},
+		{
+			"is successful",
+			input{
+				getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+					if u.Query().Get("symbol") != "AAPL" || u.Query().Get("outputsize") != "2" {
+						return nil, errors.New("unexpected query")
+					}
+					return symbolSearchBody, nil
+				},
+			},
+			want{
+				matches: 2,
+			},
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			client := client{
+				c:     http.DefaultClient,
+				getFn: tt.input.getFn,
+			}
+
+			matches, err := client.SymbolSearch("AAPL", SymbolSearchOptions{OutputSize: 2})
+			if tt.want.err {
+				if assert.NotNil(t, err) {
+					assert.Contains(t, err.Error(), tt.want.contains)
+				}
+				return
+			}
+
+			if assert.Nil(t, err) && assert.Len(t, matches, tt.want.matches) {
+				assert.Equal(t, "XMEX", matches[1].MicCode)
+				assert.Equal(t, "America/Mexico_City", matches[1].ExchangeTimezone)
+			}
+		})
+	}
+}
+
+func TestUnitInstruments(t *testing.T) {
+	var path string
+	var query url.Values
+	client := client{
+		apiKey: "key",
+		c:      http.DefaultClient,
+		getFn: func(ctx context.Context, u *url.URL) ([]byte, error) {
+			path, query = u.Path, u.Query()
+			switch u.Path {
+			case "/stocks":
+				return stocksBody, nil
+			case "/cryptocurrencies":
+				return cryptoBody, nil
+			case "/funds", "/bonds":
+				return fundsBody, nil
+			default:
+				return []byte(`{"data":[{"symbol":"TEST"}],"status":"ok"}`), nil
+			}
+		},
+	}
+
+	stocks, err := client.Stocks(ListOptions{Exchange: "NASDAQ", Country: "United States", Type: "Common Stock"})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "key", query.Get("apikey"))
+		assert.Equal(t, "NASDAQ", query.Get("exchange"))
+		assert.Equal(t, "United States", query.Get("country"))
+		assert.Equal(t, "Common Stock", query.Get("type"))
+		assert.Equal(t, []Stock{{Symbol: "AAPL", Name: "Apple Inc", Currency: "USD", Exchange: "NASDAQ", MicCode: "XNGS", Country: "United States", Type: "Common Stock"}}, stocks)
+	}
+
+	crypto, err := client.Cryptocurrencies(ListOptions{Symbol: "BTC/USD"})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "BTC/USD", query.Get("symbol"))
+		assert.Equal(t, []string{"Binance", "Coinbase Pro"}, crypto[0].AvailableExchanges)
+	}
+
+	funds, err := client.Funds(ListOptions{})
+	if assert.Nil(t, err) && assert.Len(t, funds, 1) {
+		assert.Equal(t, "VTSAX", funds[0].Symbol)
+		assert.Equal(t, "Mutual Fund", funds[0].Type)
+	}
+
+	bonds, err := client.Bonds(ListOptions{})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "/bonds", path)
+		assert.Len(t, bonds, 1)
+	}
+
+	forex, err := client.ForexPairs(ListOptions{})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "/forex_pairs", path)
+		assert.Equal(t, "TEST", forex[0].Symbol)
+	}
+
+	etfs, err := client.ETFs(ListOptions{})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "/etf", path)
+		assert.Equal(t, "TEST", etfs[0].Symbol)
+	}
+
+	indices, err := client.Indices(ListOptions{MICCode: "XNGS"})
+	if assert.Nil(t, err) {
+		assert.Equal(t, "/indices", path)
+		assert.Equal(t, "XNGS", query.Get("mic_code"))
+		assert.Equal(t, "TEST", indices[0].Symbol)
+	}
+}
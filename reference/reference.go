@@ -0,0 +1,147 @@
+package reference
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+
+	httpt "github.com/DefinitelyNotAGoat/twelvedata/http"
+	"github.com/pkg/errors"
+)
+
+type getFn func(ctx context.Context, u *url.URL) ([]byte, error)
+
+// Client - Exposes an interface to interact with Twelvedata's reference data API: https://twelvedata.com/docs#reference-data
+type Client interface {
+	SymbolSearch(query string, opts SymbolSearchOptions) ([]SymbolMatch, error)
+	SymbolSearchWithContext(ctx context.Context, query string, opts SymbolSearchOptions) ([]SymbolMatch, error)
+	Stocks(opts ListOptions) ([]Stock, error)
+	StocksWithContext(ctx context.Context, opts ListOptions) ([]Stock, error)
+	ForexPairs(opts ListOptions) ([]ForexPair, error)
+	ForexPairsWithContext(ctx context.Context, opts ListOptions) ([]ForexPair, error)
+	Cryptocurrencies(opts ListOptions) ([]Cryptocurrency, error)
+	CryptocurrenciesWithContext(ctx context.Context, opts ListOptions) ([]Cryptocurrency, error)
+	ETFs(opts ListOptions) ([]ETF, error)
+	ETFsWithContext(ctx context.Context, opts ListOptions) ([]ETF, error)
+	Indices(opts ListOptions) ([]Index, error)
+	IndicesWithContext(ctx context.Context, opts ListOptions) ([]Index, error)
+	Funds(opts ListOptions) ([]Fund, error)
+	FundsWithContext(ctx context.Context, opts ListOptions) ([]Fund, error)
+	Bonds(opts ListOptions) ([]Bond, error)
+	BondsWithContext(ctx context.Context, opts ListOptions) ([]Bond, error)
+	Exchanges(opts ListOptions) ([]Exchange, error)
+	ExchangesWithContext(ctx context.Context, opts ListOptions) ([]Exchange, error)
+	CryptocurrencyExchanges() ([]CryptocurrencyExchange, error)
+	CryptocurrencyExchangesWithContext(ctx context.Context) ([]CryptocurrencyExchange, error)
+	Countries() ([]Country, error)
+	CountriesWithContext(ctx context.Context) ([]Country, error)
+	InstrumentTypes() ([]string, error)
+	InstrumentTypesWithContext(ctx context.Context) ([]string, error)
+	TechnicalIndicators() (map[string]TechnicalIndicator, error)
+	TechnicalIndicatorsWithContext(ctx context.Context) (map[string]TechnicalIndicator, error)
+}
+
+type client struct {
+	apiKey  string
+	baseURL string
+	c       *http.Client
+	getFn   getFn
+}
+
+// New - returns a new Twelvedata's reference data Client
+func New(apiKey string, c *http.Client, opts ...httpt.Option) Client {
+	httpClient := httpt.NewClient(c, opts...)
+
+	return &client{
+		apiKey:  apiKey,
+		baseURL: httpClient.BaseURL(),
+		c:       c,
+		getFn:   httpClient.Get,
+	}
+}
+
+// ListOptions - filters for the twelvedata reference data lists, each list supports the subset its docs list
+type ListOptions struct {
+	Symbol   string
+	Exchange string
+	MICCode  string
+	Country  string
+	// Type - the instrument type, see Client.InstrumentTypes
+	Type string
+}
+
+func (l ListOptions) params(u *url.URL, urlValues url.Values) {
+	if l.Symbol != "" {
+		urlValues.Add("symbol", l.Symbol)
+	}
+
+	if l.Exchange != "" {
+		urlValues.Add("exchange", l.Exchange)
+	}
+
+	if l.MICCode != "" {
+		urlValues.Add("mic_code", l.MICCode)
+	}
+
+	if l.Country != "" {
+		urlValues.Add("country", l.Country)
+	}
+
+	if l.Type != "" {
+		urlValues.Add("type", l.Type)
+	}
+
+	u.RawQuery = urlValues.Encode()
+}
+
+// get - requests a reference data endpoint and decodes its body into response
+func (c *client) get(ctx context.Context, endpoint string, urlValues url.Values, response any) error {
+	u, err := url.Parse(fmt.Sprintf("%s/%s", c.baseURL, endpoint))
+	if err != nil {
+		return errors.Wrapf(err, "failed to parse base URL '%s'", c.baseURL)
+	}
+
+	urlValues.Set("apikey", c.apiKey)
+	u.RawQuery = urlValues.Encode()
+
+	body, err := c.getFn(ctx, u)
+	if err != nil {
+		return err
+	}
+
+	return json.Unmarshal(body, response)
+}
+
+// list - requests a reference data list, which twelvedata returns under data
+func list[T any](ctx context.Context, c *client, endpoint string, opts ListOptions) ([]T, error) {
+	u := &url.URL{}
+	opts.params(u, url.Values{})
+
+	var response struct {
+		Data []T `json:"data"`
+	}
+	if err := c.get(ctx, endpoint, u.Query(), &response); err != nil {
+		return nil, err
+	}
+
+	return response.Data, nil
+}
+
+// resultList - requests a reference data list, which twelvedata returns under result.list
+func resultList[T any](ctx context.Context, c *client, endpoint string, opts ListOptions) ([]T, error) {
+	u := &url.URL{}
+	opts.params(u, url.Values{})
+
+	var response struct {
+		Result struct {
+			List []T `json:"list"`
+		} `json:"result"`
+	}
+	if err := c.get(ctx, endpoint, u.Query(), &response); err != nil {
+		return nil, err
+	}
+
+	return response.Result.List, nil
+}
@@ -0,0 +1,88 @@
+package stream
+
+import (
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+// Options - configures a streaming Client
+type Options struct {
+	URL                 string
+	HeartbeatInterval   time.Duration
+	ReconnectBackoff    time.Duration
+	MaxReconnectBackoff time.Duration
+	Dialer              *websocket.Dialer
+	OnPrice             func(PriceEvent)
+	OnStatus            func(StatusEvent)
+	OnError             func(error)
+}
+
+// Option - configures Options when constructing a Client
+type Option func(*Options)
+
+// WithURL - connects to url instead of DefaultURL, e.g. a local stub
+func WithURL(url string) Option {
+	return func(o *Options) {
+		o.URL = url
+	}
+}
+
+// WithHeartbeatInterval - how often a heartbeat is sent to keep the connection alive
+func WithHeartbeatInterval(interval time.Duration) Option {
+	return func(o *Options) {
+		o.HeartbeatInterval = interval
+	}
+}
+
+// WithReconnectBackoff - the initial and maximum wait between reconnection attempts, doubled after every failed attempt
+func WithReconnectBackoff(initial, max time.Duration) Option {
+	return func(o *Options) {
+		o.ReconnectBackoff = initial
+		o.MaxReconnectBackoff = max
+	}
+}
+
+// WithDialer - dials the WebSocket with dialer, e.g. to configure a proxy or TLS
+func WithDialer(dialer *websocket.Dialer) Option {
+	return func(o *Options) {
+		o.Dialer = dialer
+	}
+}
+
+// WithPriceHandler - delivers price events to fn instead of the Prices channel
+func WithPriceHandler(fn func(PriceEvent)) Option {
+	return func(o *Options) {
+		o.OnPrice = fn
+	}
+}
+
+// WithStatusHandler - delivers subscribe and unsubscribe status events to fn
+func WithStatusHandler(fn func(StatusEvent)) Option {
+	return func(o *Options) {
+		o.OnStatus = fn
+	}
+}
+
+// WithErrorHandler - reports connection and decoding errors to fn, Run keeps reconnecting regardless
+func WithErrorHandler(fn func(error)) Option {
+	return func(o *Options) {
+		o.OnError = fn
+	}
+}
+
+func newOptions(opts ...Option) Options {
+	options := Options{
+		URL:                 DefaultURL,
+		HeartbeatInterval:   10 * time.Second,
+		ReconnectBackoff:    time.Second,
+		MaxReconnectBackoff: time.Minute,
+		Dialer:              websocket.DefaultDialer,
+	}
+
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return options
+}
@@ -0,0 +1,363 @@
+package stream
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+)
+
+// DefaultURL - the twelvedata real time price WebSocket: https://twelvedata.com/docs#real-time-price-websocket
+const DefaultURL = "wss://ws.twelvedata.com/v1/quotes/price"
+
+// Client - Exposes an interface to stream real time prices from Twelvedata's WebSocket API
+type Client interface {
+	// Run - connects and delivers events until ctx is done, reconnecting and resubscribing whenever the connection drops.
+	// The Prices channel is closed once Run returns, so a Client can only be run once
+	Run(ctx context.Context) error
+	// Subscribe - adds symbols to the subscription, sent immediately when connected and on every reconnect
+	Subscribe(symbols ...string) error
+	// Unsubscribe - removes symbols from the subscription
+	Unsubscribe(symbols ...string) error
+	// Symbols - the symbols currently subscribed to
+	Symbols() []string
+	// Prices - the channel price events are delivered on when no price handler is configured, closed once Run returns
+	Prices() <-chan PriceEvent
+}
+
+// PriceEvent - a price tick for a subscribed symbol
+type PriceEvent struct {
+	Symbol        string    `json:"symbol"`
+	Currency      string    `json:"currency"`
+	CurrencyBase  string    `json:"currency_base"`
+	CurrencyQuote string    `json:"currency_quote"`
+	Exchange      string    `json:"exchange"`
+	MicCode       string    `json:"mic_code"`
+	Type          string    `json:"type"`
+	Timestamp     time.Time `json:"timestamp"`
+	Price         float64   `json:"price"`
+	Bid           float64   `json:"bid"`
+	Ask           float64   `json:"ask"`
+	DayVolume     float64   `json:"day_volume"`
+}
+
+// UnmarshalJSON - unmarshal's PriceEvent to a more consumable type
+func (p *PriceEvent) UnmarshalJSON(b []byte) error {
+	type RawPriceEvent struct {
+		Symbol        string  `json:"symbol"`
+		Currency      string  `json:"currency"`
+		CurrencyBase  string  `json:"currency_base"`
+		CurrencyQuote string  `json:"currency_quote"`
+		Exchange      string  `json:"exchange"`
+		MicCode       string  `json:"mic_code"`
+		Type          string  `json:"type"`
+		Timestamp     int64   `json:"timestamp"`
+		Price         float64 `json:"price"`
+		Bid           float64 `json:"bid"`
+		Ask           float64 `json:"ask"`
+		DayVolume     float64 `json:"day_volume"`
+	}
+
+	var raw RawPriceEvent
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return err
+	}
+
+	*p = PriceEvent{
+		Symbol:        raw.Symbol,
+		Currency:      raw.Currency,
+		CurrencyBase:  raw.CurrencyBase,
+		CurrencyQuote: raw.CurrencyQuote,
+		Exchange:      raw.Exchange,
+		MicCode:       raw.MicCode,
+		Type:          raw.Type,
+		Timestamp:     time.Unix(raw.Timestamp, 0),
+		Price:         raw.Price,
+		Bid:           raw.Bid,
+		Ask:           raw.Ask,
+		DayVolume:     raw.DayVolume,
+	}
+
+	return nil
+}
+
+// StatusEvent - the server's answer to a subscribe or unsubscribe action
+type StatusEvent struct {
+	Event   string         `json:"event"`
+	Status  string         `json:"status"`
+	Success []SymbolStatus `json:"success"`
+	Fails   []SymbolStatus `json:"fails"`
+}
+
+// SymbolStatus - a symbol listed in a StatusEvent
+type SymbolStatus struct {
+	Symbol   string `json:"symbol"`
+	Exchange string `json:"exchange"`
+	MicCode  string `json:"mic_code"`
+	Country  string `json:"country"`
+	Type     string `json:"type"`
+}
+
+type action struct {
+	Action string        `json:"action"`
+	Params *actionParams `json:"params,omitempty"`
+}
+
+type actionParams struct {
+	Symbols string `json:"symbols"`
+}
+
+type client struct {
+	apiKey string
+	opts   Options
+	prices chan PriceEvent
+	ran    int32
+
+	mu      sync.Mutex
+	symbols map[string]struct{}
+	conn    *websocket.Conn
+	writeMu sync.Mutex
+}
+
+// New - returns a new Twelvedata's streaming Client
+func New(apiKey string, opts ...Option) Client {
+	return &client{
+		apiKey:  apiKey,
+		opts:    newOptions(opts...),
+		prices:  make(chan PriceEvent, 256),
+		symbols: map[string]struct{}{},
+	}
+}
+
+// Prices - the channel price events are delivered on when no price handler is configured, closed once Run returns
+func (c *client) Prices() <-chan PriceEvent {
+	return c.prices
+}
+
+// Symbols - the symbols currently subscribed to
+func (c *client) Symbols() []string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.sortedSymbols()
+}
+
+// Subscribe - adds symbols to the subscription, sent immediately when connected and on every reconnect
+func (c *client) Subscribe(symbols ...string) error {
+	c.mu.Lock()
+	for _, symbol := range symbols {
+		c.symbols[symbol] = struct{}{}
+	}
+	conn := c.conn
+	c.mu.Unlock()
+
+	return c.sendSymbols(conn, "subscribe", symbols)
+}
+
+// Unsubscribe - removes symbols from the subscription
+func (c *client) Unsubscribe(symbols ...string) error {
+	c.mu.Lock()
+	for _, symbol := range symbols {
+		delete(c.symbols, symbol)
+	}
+	conn := c.conn
+	c.mu.Unlock()
+
+	return c.sendSymbols(conn, "unsubscribe", symbols)
+}
+
+func (c *client) sendSymbols(conn *websocket.Conn, actionName string, symbols []string) error {
+	if len(symbols) == 0 {
+		return nil
+	}
+
+	// not connected yet, the subscription is sent once Run connects
+	if conn == nil {
+		return nil
+	}
+
+	return c.write(conn, action{
+		Action: actionName,
+		Params: &actionParams{Symbols: strings.Join(symbols, ",")},
+	})
+}
+
+func (c *client) write(conn *websocket.Conn, a action) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+
+	if err := conn.WriteJSON(a); err != nil {
+		return errors.Wrapf(err, "failed to send %s action", a.Action)
+	}
+
+	return nil
+}
+
+// Run - connects and delivers events until ctx is done, reconnecting and resubscribing whenever the connection drops.
+// The Prices channel is closed once Run returns, so a Client can only be run once
+func (c *client) Run(ctx context.Context) error {
+	if !atomic.CompareAndSwapInt32(&c.ran, 0, 1) {
+		return errors.New("stream client has already been run, create a new one to reconnect")
+	}
+	// dispatch only sends from this goroutine, so nothing can send on prices once Run returns
+	defer close(c.prices)
+
+	backoff := c.opts.ReconnectBackoff
+	for {
+		connected, err := c.session(ctx)
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if connected {
+			backoff = c.opts.ReconnectBackoff
+		}
+
+		if c.opts.OnError != nil && err != nil {
+			c.opts.OnError(err)
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		backoff *= 2
+		if backoff > c.opts.MaxReconnectBackoff {
+			backoff = c.opts.MaxReconnectBackoff
+		}
+	}
+}
+
+// session - runs a single connection until it drops, reporting whether it connected at all
+func (c *client) session(ctx context.Context) (bool, error) {
+	u, err := url.Parse(c.opts.URL)
+	if err != nil {
+		return false, errors.Wrapf(err, "failed to parse stream URL '%s'", c.opts.URL)
+	}
+	u.RawQuery = url.Values{"apikey": {c.apiKey}}.Encode()
+
+	conn, resp, err := c.opts.Dialer.DialContext(ctx, u.String(), http.Header{})
+	if err != nil {
+		if resp != nil {
+			return false, errors.Wrapf(err, "failed to connect with status code '%d'", resp.StatusCode)
+		}
+		return false, errors.Wrap(err, "failed to connect")
+	}
+	defer conn.Close()
+
+	c.mu.Lock()
+	c.conn = conn
+	symbols := c.sortedSymbols()
+	c.mu.Unlock()
+
+	defer func() {
+		c.mu.Lock()
+		c.conn = nil
+		c.mu.Unlock()
+	}()
+
+	if err := c.sendSymbols(conn, "subscribe", symbols); err != nil {
+		return true, err
+	}
+
+	done := make(chan struct{})
+	defer close(done)
+	go c.heartbeat(ctx, conn, done)
+
+	for {
+		_, message, err := conn.ReadMessage()
+		if err != nil {
+			return true, errors.Wrap(err, "connection dropped")
+		}
+
+		c.dispatch(ctx, message)
+	}
+}
+
+// heartbeat - keeps the connection alive, closing it once ctx is done to unblock the reader
+func (c *client) heartbeat(ctx context.Context, conn *websocket.Conn, done <-chan struct{}) {
+	ticker := time.NewTicker(c.opts.HeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-done:
+			return
+		case <-ctx.Done():
+			conn.Close()
+			return
+		case <-ticker.C:
+			if err := c.write(conn, action{Action: "heartbeat"}); err != nil {
+				conn.Close()
+				return
+			}
+		}
+	}
+}
+
+func (c *client) dispatch(ctx context.Context, message []byte) {
+	var envelope struct {
+		Event string `json:"event"`
+	}
+	if err := json.Unmarshal(message, &envelope); err != nil {
+		c.reportError(errors.Wrap(err, "failed to unmarshal stream event"))
+		return
+	}
+
+	switch envelope.Event {
+	case "price":
+		var event PriceEvent
+		if err := json.Unmarshal(message, &event); err != nil {
+			c.reportError(errors.Wrap(err, "failed to unmarshal price event"))
+			return
+		}
+
+		if c.opts.OnPrice != nil {
+			c.opts.OnPrice(event)
+			return
+		}
+
+		select {
+		case c.prices <- event:
+		case <-ctx.Done():
+		}
+	case "subscribe-status", "unsubscribe-status":
+		var event StatusEvent
+		if err := json.Unmarshal(message, &event); err != nil {
+			c.reportError(errors.Wrap(err, "failed to unmarshal status event"))
+			return
+		}
+
+		if c.opts.OnStatus != nil {
+			c.opts.OnStatus(event)
+		}
+	}
+}
+
+func (c *client) reportError(err error) {
+	if c.opts.OnError != nil {
+		c.opts.OnError(err)
+	}
+}
+
+func (c *client) sortedSymbols() []string {
+	symbols := make([]string, 0, len(c.symbols))
+	for symbol := range c.symbols {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+
+	return symbols
+}
@@ -0,0 +1,198 @@
+package stream
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	priceEventBody = []byte(`{"event":"price","symbol":"AAPL","currency":"USD","exchange":"NASDAQ","mic_code":"XNGS","type":"Common Stock","timestamp":1692883800,"price":176.37,"day_volume":54945800}`)
+	statusBody     = []byte(`{"event":"subscribe-status","status":"ok","success":[{"symbol":"AAPL","exchange":"NASDAQ","mic_code":"XNGS","country":"United States","type":"Common Stock"}],"fails":[]}`)
+)
+
+func TestIntegrationStream(t *testing.T) {
+	client := New(os.Getenv("TWELVEDATA_API_KEY"))
+	if err := client.Subscribe("AAPL"); err != nil {
+		t.Fatal("Failed to subscribe: ", err.Error())
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	go func() {
+		_ = client.Run(ctx)
+	}()
+
+	select {
+	case <-client.Prices():
+	case <-ctx.Done():
+		t.Log("Failed to receive a price event")
+		t.Fail()
+	}
+}
+
+func TestUnitStream(t *testing.T) {
+	stub := newStubServer(t)
+	defer stub.close()
+
+	var statuses []StatusEvent
+	var statusMu sync.Mutex
+	client := New(
+		"key",
+		WithURL(stub.url()),
+		WithHeartbeatInterval(10*time.Millisecond),
+		WithReconnectBackoff(time.Millisecond, 10*time.Millisecond),
+		WithStatusHandler(func(event StatusEvent) {
+			statusMu.Lock()
+			statuses = append(statuses, event)
+			statusMu.Unlock()
+		}),
+	)
+
+	assert.Nil(t, client.Subscribe("AAPL", "MSFT"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	runErr := make(chan error, 1)
+	go func() {
+		runErr <- client.Run(ctx)
+	}()
+
+	// subscriptions recorded before Run are sent on connect
+	assert.Equal(t, `{"action":"subscribe","params":{"symbols":"AAPL,MSFT"}}`, stub.next(t, ctx, "subscribe"))
+
+	stub.send(t, statusBody)
+	stub.send(t, priceEventBody)
+	select {
+	case event := <-client.Prices():
+		assert.Equal(t, "AAPL", event.Symbol)
+		assert.Equal(t, 176.37, event.Price)
+		assert.Equal(t, time.Unix(1692883800, 0), event.Timestamp)
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for price event")
+	}
+
+	assert.Equal(t, `{"action":"heartbeat"}`, stub.next(t, ctx, "heartbeat"))
+
+	assert.Nil(t, client.Unsubscribe("MSFT"))
+	assert.Equal(t, `{"action":"unsubscribe","params":{"symbols":"MSFT"}}`, stub.next(t, ctx, "unsubscribe"))
+	assert.Equal(t, []string{"AAPL"}, client.Symbols())
+
+	// dropping the connection reconnects and resubscribes the remaining symbols
+	stub.drop()
+	assert.Equal(t, `{"action":"subscribe","params":{"symbols":"AAPL"}}`, stub.next(t, ctx, "subscribe"))
+	assert.GreaterOrEqual(t, stub.connections(), 2)
+
+	cancel()
+	assert.ErrorIs(t, <-runErr, context.Canceled)
+
+	// the prices channel is closed once Run returns, ending any range over it
+	_, open := <-client.Prices()
+	assert.False(t, open)
+	assert.NotNil(t, client.Run(context.Background()))
+
+	statusMu.Lock()
+	defer statusMu.Unlock()
+	if assert.Len(t, statuses, 1) {
+		assert.Equal(t, "AAPL", statuses[0].Success[0].Symbol)
+	}
+}
+
+// stubServer - a local stand in for twelvedata's WebSocket, recording every action it receives
+type stubServer struct {
+	server   *httptest.Server
+	upgrader websocket.Upgrader
+	messages chan string
+
+	mu    sync.Mutex
+	conn  *websocket.Conn
+	count int
+}
+
+func newStubServer(t *testing.T) *stubServer {
+	stub := &stubServer{messages: make(chan string, 100)}
+	stub.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Query().Get("apikey") != "key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		conn, err := stub.upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Log("Failed to upgrade stub connection: ", err.Error())
+			return
+		}
+
+		stub.mu.Lock()
+		stub.conn = conn
+		stub.count++
+		stub.mu.Unlock()
+
+		for {
+			_, message, err := conn.ReadMessage()
+			if err != nil {
+				return
+			}
+			stub.messages <- strings.TrimSpace(string(message))
+		}
+	}))
+
+	return stub
+}
+
+func (s *stubServer) url() string {
+	return "ws" + strings.TrimPrefix(s.server.URL, "http")
+}
+
+// next - the next received message containing action, skipping any others such as heartbeats
+func (s *stubServer) next(t *testing.T, ctx context.Context, action string) string {
+	for {
+		select {
+		case message := <-s.messages:
+			if strings.Contains(message, `"action":"`+action+`"`) {
+				return message
+			}
+		case <-ctx.Done():
+			t.Fatalf("timed out waiting for %s action", action)
+			return ""
+		}
+	}
+}
+
+func (s *stubServer) send(t *testing.T, message []byte) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.conn.WriteMessage(websocket.TextMessage, message); err != nil {
+		t.Fatal("Failed to write stub message: ", err.Error())
+	}
+}
+
+func (s *stubServer) drop() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.conn.Close()
+}
+
+func (s *stubServer) connections() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.count
+}
+
+func (s *stubServer) close() {
+	s.server.CloseClientConnections()
+	s.server.Close()
+}
@@ -3,20 +3,27 @@ package twelvedata
 import (
 	"net/http"
 
+	"github.com/DefinitelyNotAGoat/twelvedata/batch"
 	"github.com/DefinitelyNotAGoat/twelvedata/core"
+	httpt "github.com/DefinitelyNotAGoat/twelvedata/http"
 	"github.com/DefinitelyNotAGoat/twelvedata/indicators"
+	"github.com/DefinitelyNotAGoat/twelvedata/reference"
 )
 
 // Client - a general wrapper that encomposes all TwelveData API groups
 type Client struct {
 	CoreData            core.Client
 	TechnicalIndicators indicators.Client
+	Batch               batch.Client
+	Reference           reference.Client
 }
 
-// New - returns a new TwelveData Client
-func New(apiKey string, client *http.Client) Client {
+// New - returns a new TwelveData Client, opts are applied to every API group
+func New(apiKey string, client *http.Client, opts ...httpt.Option) Client {
 	return Client{
-		CoreData:            core.New(apiKey, client),
-		TechnicalIndicators: indicators.New(apiKey, client),
+		CoreData:            core.New(apiKey, client, opts...),
+		TechnicalIndicators: indicators.New(apiKey, client, opts...),
+		Batch:               batch.New(apiKey, client, opts...),
+		Reference:           reference.New(apiKey, client, opts...),
 	}
 }